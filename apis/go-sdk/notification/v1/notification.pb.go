@@ -9,11 +9,13 @@ package v1
 import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	proto "github.com/golang/protobuf/proto"
-	any "github.com/golang/protobuf/ptypes/any"
+	any1 "github.com/golang/protobuf/ptypes/any"
+	duration "github.com/golang/protobuf/ptypes/duration"
 	empty "github.com/golang/protobuf/ptypes/empty"
 	_ "github.com/golang/protobuf/ptypes/struct"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -31,6 +33,372 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type Platform int32
+
+const (
+	Platform_PLATFORM_UNSPECIFIED Platform = 0
+	Platform_ANDROID              Platform = 1
+	Platform_IOS                  Platform = 2
+	Platform_WEB                  Platform = 3
+)
+
+// Enum value maps for Platform.
+var (
+	Platform_name = map[int32]string{
+		0: "PLATFORM_UNSPECIFIED",
+		1: "ANDROID",
+		2: "IOS",
+		3: "WEB",
+	}
+	Platform_value = map[string]int32{
+		"PLATFORM_UNSPECIFIED": 0,
+		"ANDROID":              1,
+		"IOS":                  2,
+		"WEB":                  3,
+	}
+)
+
+func (x Platform) Enum() *Platform {
+	p := new(Platform)
+	*p = x
+	return p
+}
+
+func (x Platform) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Platform) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_notification_proto_enumTypes[0].Descriptor()
+}
+
+func (Platform) Type() protoreflect.EnumType {
+	return &file_v1_notification_proto_enumTypes[0]
+}
+
+func (x Platform) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Platform.Descriptor instead.
+func (Platform) EnumDescriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{0}
+}
+
+// Consent of a ref to a category or a channel. Unspecified consents are inherited,
+// from the category for channels, or opted in for categories
+type Consent int32
+
+const (
+	Consent_CONSENT_UNSPECIFIED Consent = 0
+	Consent_OPT_IN              Consent = 1
+	Consent_OPT_OUT             Consent = 2
+)
+
+// Enum value maps for Consent.
+var (
+	Consent_name = map[int32]string{
+		0: "CONSENT_UNSPECIFIED",
+		1: "OPT_IN",
+		2: "OPT_OUT",
+	}
+	Consent_value = map[string]int32{
+		"CONSENT_UNSPECIFIED": 0,
+		"OPT_IN":              1,
+		"OPT_OUT":             2,
+	}
+)
+
+func (x Consent) Enum() *Consent {
+	p := new(Consent)
+	*p = x
+	return p
+}
+
+func (x Consent) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Consent) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_notification_proto_enumTypes[1].Descriptor()
+}
+
+func (Consent) Type() protoreflect.EnumType {
+	return &file_v1_notification_proto_enumTypes[1]
+}
+
+func (x Consent) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Consent.Descriptor instead.
+func (Consent) EnumDescriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{1}
+}
+
+type ListInstancesRequest_TokenFilter int32
+
+const (
+	// TOKEN_ANY returns instances regardless of their token
+	ListInstancesRequest_TOKEN_ANY ListInstancesRequest_TokenFilter = 0
+	// TOKEN_PRESENT returns only instances with a token
+	ListInstancesRequest_TOKEN_PRESENT ListInstancesRequest_TokenFilter = 1
+	// TOKEN_ABSENT returns only instances without a token (e.g. after RemoveToken)
+	ListInstancesRequest_TOKEN_ABSENT ListInstancesRequest_TokenFilter = 2
+)
+
+// Enum value maps for ListInstancesRequest_TokenFilter.
+var (
+	ListInstancesRequest_TokenFilter_name = map[int32]string{
+		0: "TOKEN_ANY",
+		1: "TOKEN_PRESENT",
+		2: "TOKEN_ABSENT",
+	}
+	ListInstancesRequest_TokenFilter_value = map[string]int32{
+		"TOKEN_ANY":     0,
+		"TOKEN_PRESENT": 1,
+		"TOKEN_ABSENT":  2,
+	}
+)
+
+func (x ListInstancesRequest_TokenFilter) Enum() *ListInstancesRequest_TokenFilter {
+	p := new(ListInstancesRequest_TokenFilter)
+	*p = x
+	return p
+}
+
+func (x ListInstancesRequest_TokenFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ListInstancesRequest_TokenFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_notification_proto_enumTypes[2].Descriptor()
+}
+
+func (ListInstancesRequest_TokenFilter) Type() protoreflect.EnumType {
+	return &file_v1_notification_proto_enumTypes[2]
+}
+
+func (x ListInstancesRequest_TokenFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ListInstancesRequest_TokenFilter.Descriptor instead.
+func (ListInstancesRequest_TokenFilter) EnumDescriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{5, 0}
+}
+
+type ScheduledNotification_State int32
+
+const (
+	ScheduledNotification_STATE_UNSPECIFIED ScheduledNotification_State = 0
+	ScheduledNotification_PENDING           ScheduledNotification_State = 1
+	ScheduledNotification_SENDING           ScheduledNotification_State = 2
+	ScheduledNotification_SENT              ScheduledNotification_State = 3
+	ScheduledNotification_FAILED            ScheduledNotification_State = 4
+	ScheduledNotification_CANCELED          ScheduledNotification_State = 5
+)
+
+// Enum value maps for ScheduledNotification_State.
+var (
+	ScheduledNotification_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "PENDING",
+		2: "SENDING",
+		3: "SENT",
+		4: "FAILED",
+		5: "CANCELED",
+	}
+	ScheduledNotification_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"PENDING":           1,
+		"SENDING":           2,
+		"SENT":              3,
+		"FAILED":            4,
+		"CANCELED":          5,
+	}
+)
+
+func (x ScheduledNotification_State) Enum() *ScheduledNotification_State {
+	p := new(ScheduledNotification_State)
+	*p = x
+	return p
+}
+
+func (x ScheduledNotification_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScheduledNotification_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_notification_proto_enumTypes[3].Descriptor()
+}
+
+func (ScheduledNotification_State) Type() protoreflect.EnumType {
+	return &file_v1_notification_proto_enumTypes[3]
+}
+
+func (x ScheduledNotification_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScheduledNotification_State.Descriptor instead.
+func (ScheduledNotification_State) EnumDescriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{31, 0}
+}
+
+type Notification_State int32
+
+const (
+	Notification_STATE_UNSPECIFIED Notification_State = 0
+	// SENT notifications were accepted by FCM
+	Notification_SENT Notification_State = 1
+	// SUPPRESSED notifications exceeded the frequency cap or their recipients opted
+	// out of the category. Deferred notifications are recorded again once they are sent
+	Notification_SUPPRESSED Notification_State = 2
+	// FAILED notifications were rejected by FCM, after all retries of transient errors.
+	// These are recorded for each token with the error as the reason
+	Notification_FAILED Notification_State = 3
+)
+
+// Enum value maps for Notification_State.
+var (
+	Notification_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "SENT",
+		2: "SUPPRESSED",
+		3: "FAILED",
+	}
+	Notification_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"SENT":              1,
+		"SUPPRESSED":        2,
+		"FAILED":            3,
+	}
+)
+
+func (x Notification_State) Enum() *Notification_State {
+	p := new(Notification_State)
+	*p = x
+	return p
+}
+
+func (x Notification_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Notification_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_notification_proto_enumTypes[4].Descriptor()
+}
+
+func (Notification_State) Type() protoreflect.EnumType {
+	return &file_v1_notification_proto_enumTypes[4]
+}
+
+func (x Notification_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Notification_State.Descriptor instead.
+func (Notification_State) EnumDescriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{37, 0}
+}
+
+type FrequencyCap_Action int32
+
+const (
+	// DROP suppresses the notification
+	FrequencyCap_DROP FrequencyCap_Action = 0
+	// DEFER schedules the notification to the time the cap allows it
+	FrequencyCap_DEFER FrequencyCap_Action = 1
+)
+
+// Enum value maps for FrequencyCap_Action.
+var (
+	FrequencyCap_Action_name = map[int32]string{
+		0: "DROP",
+		1: "DEFER",
+	}
+	FrequencyCap_Action_value = map[string]int32{
+		"DROP":  0,
+		"DEFER": 1,
+	}
+)
+
+func (x FrequencyCap_Action) Enum() *FrequencyCap_Action {
+	p := new(FrequencyCap_Action)
+	*p = x
+	return p
+}
+
+func (x FrequencyCap_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FrequencyCap_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_notification_proto_enumTypes[5].Descriptor()
+}
+
+func (FrequencyCap_Action) Type() protoreflect.EnumType {
+	return &file_v1_notification_proto_enumTypes[5]
+}
+
+func (x FrequencyCap_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FrequencyCap_Action.Descriptor instead.
+func (FrequencyCap_Action) EnumDescriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{43, 0}
+}
+
+type QuietHours_Action int32
+
+const (
+	// DEFER schedules the notification to the end of the window
+	QuietHours_DEFER QuietHours_Action = 0
+	// SILENT sends the notification as a data message without an alert
+	QuietHours_SILENT QuietHours_Action = 1
+)
+
+// Enum value maps for QuietHours_Action.
+var (
+	QuietHours_Action_name = map[int32]string{
+		0: "DEFER",
+		1: "SILENT",
+	}
+	QuietHours_Action_value = map[string]int32{
+		"DEFER":  0,
+		"SILENT": 1,
+	}
+)
+
+func (x QuietHours_Action) Enum() *QuietHours_Action {
+	p := new(QuietHours_Action)
+	*p = x
+	return p
+}
+
+func (x QuietHours_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QuietHours_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_notification_proto_enumTypes[6].Descriptor()
+}
+
+func (QuietHours_Action) Type() protoreflect.EnumType {
+	return &file_v1_notification_proto_enumTypes[6]
+}
+
+func (x QuietHours_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QuietHours_Action.Descriptor instead.
+func (QuietHours_Action) EnumDescriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{44, 0}
+}
+
 type AppInstance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -56,6 +424,47 @@ type AppInstance struct {
 	// to group users or add metadata when needed.
 	// @inject_tag: firestore:"labels,omitempty"
 	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" firestore:"labels,omitempty"`
+	// last_seen_at is the time of the last PutInstance call of the instance.
+	// This field is set by the server
+	// @inject_tag: firestore:"lastSeenAt,omitempty"
+	LastSeenAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty" firestore:"lastSeenAt,omitempty"`
+	// platform is the platform the application runs on
+	// @inject_tag: firestore:"platform,omitempty"
+	Platform Platform `protobuf:"varint,6,opt,name=platform,proto3,enum=fcmcompanion.v1.Platform" json:"platform,omitempty" firestore:"platform,omitempty"`
+	// app_version is the version of the application, e.g. 1.4.2
+	// @inject_tag: firestore:"appVersion,omitempty"
+	AppVersion string `protobuf:"bytes,7,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty" firestore:"appVersion,omitempty"`
+	// os_version is the version of the operating system, e.g. 14.1
+	// @inject_tag: firestore:"osVersion,omitempty"
+	OsVersion string `protobuf:"bytes,8,opt,name=os_version,json=osVersion,proto3" json:"os_version,omitempty" firestore:"osVersion,omitempty"`
+	// sdk_version is the version of the Firebase SDK used by the application
+	// @inject_tag: firestore:"sdkVersion,omitempty"
+	SdkVersion string `protobuf:"bytes,9,opt,name=sdk_version,json=sdkVersion,proto3" json:"sdk_version,omitempty" firestore:"sdkVersion,omitempty"`
+	// created_at is the time the instance was first registered.
+	// This field is set by the server
+	// @inject_tag: firestore:"createdAt,omitempty"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" firestore:"createdAt,omitempty"`
+	// updated_at is the time of the last change of the instance.
+	// This field is set by the server
+	// @inject_tag: firestore:"updatedAt,omitempty"
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" firestore:"updatedAt,omitempty"`
+	// token_updated_at is the time the token was last set, rotated, or removed.
+	// This field is set by the server
+	// @inject_tag: firestore:"tokenUpdatedAt,omitempty"
+	TokenUpdatedAt *timestamp.Timestamp `protobuf:"bytes,12,opt,name=token_updated_at,json=tokenUpdatedAt,proto3" json:"token_updated_at,omitempty" firestore:"tokenUpdatedAt,omitempty"`
+	// timezone is the IANA timezone of the instance, e.g. Europe/Prague. It's used to
+	// deliver messages at a local time
+	// @inject_tag: firestore:"timezone,omitempty"
+	Timezone string `protobuf:"bytes,14,opt,name=timezone,proto3" json:"timezone,omitempty" firestore:"timezone,omitempty"`
+	// quiet_hours are the quiet hours preferred by the user of the instance
+	// @inject_tag: firestore:"quietHours,omitempty"
+	QuietHours *QuietHours `protobuf:"bytes,15,opt,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty" firestore:"quietHours,omitempty"`
+	// update_mask defines the fields written by PutInstance, e.g. ["ref", "labels"].
+	// Empty values of the masked fields clear them. Supported fields are token, ref,
+	// labels, platform, app_version, os_version, sdk_version, timezone, and quiet_hours.
+	// This field is never stored
+	// @inject_tag: firestore:"-"
+	UpdateMask *field_mask.FieldMask `protobuf:"bytes,13,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty" firestore:"-"`
 }
 
 func (x *AppInstance) Reset() {
@@ -118,6 +527,83 @@ func (x *AppInstance) GetLabels() map[string]string {
 	return nil
 }
 
+func (x *AppInstance) GetLastSeenAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *AppInstance) GetPlatform() Platform {
+	if x != nil {
+		return x.Platform
+	}
+	return Platform_PLATFORM_UNSPECIFIED
+}
+
+func (x *AppInstance) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *AppInstance) GetOsVersion() string {
+	if x != nil {
+		return x.OsVersion
+	}
+	return ""
+}
+
+func (x *AppInstance) GetSdkVersion() string {
+	if x != nil {
+		return x.SdkVersion
+	}
+	return ""
+}
+
+func (x *AppInstance) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AppInstance) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *AppInstance) GetTokenUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.TokenUpdatedAt
+	}
+	return nil
+}
+
+func (x *AppInstance) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *AppInstance) GetQuietHours() *QuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *AppInstance) GetUpdateMask() *field_mask.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
 type RemoveTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -214,16 +700,23 @@ func (x *RemoveInstanceRequest) GetInstanceId() string {
 	return ""
 }
 
-type SendRequest struct {
+type UpdateLabelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// instance_id is the unique identifier used to identify devices
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// set are the labels added or overwritten
+	Set map[string]string `protobuf:"bytes,2,rep,name=set,proto3" json:"set,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// remove are the keys of the labels removed
+	Remove []string `protobuf:"bytes,3,rep,name=remove,proto3" json:"remove,omitempty"`
+	// replace replaces all labels of the instance with the set labels
+	Replace bool `protobuf:"varint,4,opt,name=replace,proto3" json:"replace,omitempty"`
 }
 
-func (x *SendRequest) Reset() {
-	*x = SendRequest{}
+func (x *UpdateLabelsRequest) Reset() {
+	*x = UpdateLabelsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_notification_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -231,13 +724,13 @@ func (x *SendRequest) Reset() {
 	}
 }
 
-func (x *SendRequest) String() string {
+func (x *UpdateLabelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendRequest) ProtoMessage() {}
+func (*UpdateLabelsRequest) ProtoMessage() {}
 
-func (x *SendRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateLabelsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_notification_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -249,28 +742,50 @@ func (x *SendRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendRequest.ProtoReflect.Descriptor instead.
-func (*SendRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateLabelsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLabelsRequest) Descriptor() ([]byte, []int) {
 	return file_v1_notification_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *SendRequest) GetMessage() *Message {
+func (x *UpdateLabelsRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Message
+		return x.InstanceId
+	}
+	return ""
+}
+
+func (x *UpdateLabelsRequest) GetSet() map[string]string {
+	if x != nil {
+		return x.Set
 	}
 	return nil
 }
 
-type SendAllRequest struct {
+func (x *UpdateLabelsRequest) GetRemove() []string {
+	if x != nil {
+		return x.Remove
+	}
+	return nil
+}
+
+func (x *UpdateLabelsRequest) GetReplace() bool {
+	if x != nil {
+		return x.Replace
+	}
+	return false
+}
+
+type GetInstanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Messages []*Message `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// instance_id is the unique identifier used to identify devices
+	InstanceId string `protobuf:"bytes,1,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
 }
 
-func (x *SendAllRequest) Reset() {
-	*x = SendAllRequest{}
+func (x *GetInstanceRequest) Reset() {
+	*x = GetInstanceRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_notification_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -278,13 +793,13 @@ func (x *SendAllRequest) Reset() {
 	}
 }
 
-func (x *SendAllRequest) String() string {
+func (x *GetInstanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendAllRequest) ProtoMessage() {}
+func (*GetInstanceRequest) ProtoMessage() {}
 
-func (x *SendAllRequest) ProtoReflect() protoreflect.Message {
+func (x *GetInstanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_notification_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -296,28 +811,44 @@ func (x *SendAllRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendAllRequest.ProtoReflect.Descriptor instead.
-func (*SendAllRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetInstanceRequest.ProtoReflect.Descriptor instead.
+func (*GetInstanceRequest) Descriptor() ([]byte, []int) {
 	return file_v1_notification_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SendAllRequest) GetMessages() []*Message {
+func (x *GetInstanceRequest) GetInstanceId() string {
 	if x != nil {
-		return x.Messages
+		return x.InstanceId
 	}
-	return nil
+	return ""
 }
 
-type SendMulticastRequest struct {
+// ListInstancesRequest filters instances by all of the set fields
+type ListInstancesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message *MulticastMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-}
-
-func (x *SendMulticastRequest) Reset() {
-	*x = SendMulticastRequest{}
+	// ref returns only instances of the ref (e.g. the user ID)
+	Ref string `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	// labels returns only instances having all of the labels with the equal values
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// token filters instances by the presence of their token
+	Token ListInstancesRequest_TokenFilter `protobuf:"varint,3,opt,name=token,proto3,enum=fcmcompanion.v1.ListInstancesRequest_TokenFilter" json:"token,omitempty"`
+	// last_seen_after and last_seen_before limit the range of last_seen_at of the
+	// instances. Both are inclusive
+	LastSeenAfter  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=last_seen_after,json=lastSeenAfter,proto3" json:"last_seen_after,omitempty"`
+	LastSeenBefore *timestamp.Timestamp `protobuf:"bytes,5,opt,name=last_seen_before,json=lastSeenBefore,proto3" json:"last_seen_before,omitempty"`
+	// platform returns only instances of the platform
+	Platform Platform `protobuf:"varint,6,opt,name=platform,proto3,enum=fcmcompanion.v1.Platform" json:"platform,omitempty"`
+	// app_version returns only instances of the application version
+	AppVersion string `protobuf:"bytes,7,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+	PageSize   int32  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken  string `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListInstancesRequest) Reset() {
+	*x = ListInstancesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_notification_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -325,13 +856,13 @@ func (x *SendMulticastRequest) Reset() {
 	}
 }
 
-func (x *SendMulticastRequest) String() string {
+func (x *ListInstancesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMulticastRequest) ProtoMessage() {}
+func (*ListInstancesRequest) ProtoMessage() {}
 
-func (x *SendMulticastRequest) ProtoReflect() protoreflect.Message {
+func (x *ListInstancesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_notification_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -343,38 +874,87 @@ func (x *SendMulticastRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMulticastRequest.ProtoReflect.Descriptor instead.
-func (*SendMulticastRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListInstancesRequest.ProtoReflect.Descriptor instead.
+func (*ListInstancesRequest) Descriptor() ([]byte, []int) {
 	return file_v1_notification_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *SendMulticastRequest) GetMessage() *MulticastMessage {
+func (x *ListInstancesRequest) GetRef() string {
 	if x != nil {
-		return x.Message
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *ListInstancesRequest) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
 	}
 	return nil
 }
 
-type Message struct {
+func (x *ListInstancesRequest) GetToken() ListInstancesRequest_TokenFilter {
+	if x != nil {
+		return x.Token
+	}
+	return ListInstancesRequest_TOKEN_ANY
+}
+
+func (x *ListInstancesRequest) GetLastSeenAfter() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSeenAfter
+	}
+	return nil
+}
+
+func (x *ListInstancesRequest) GetLastSeenBefore() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastSeenBefore
+	}
+	return nil
+}
+
+func (x *ListInstancesRequest) GetPlatform() Platform {
+	if x != nil {
+		return x.Platform
+	}
+	return Platform_PLATFORM_UNSPECIFIED
+}
+
+func (x *ListInstancesRequest) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *ListInstancesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListInstancesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListUserDevicesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TemplateId string `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
-	// templateData is used to replace dynamic values inside the
-	// FCM message configuration
-	TemplateData map[string]string `protobuf:"bytes,2,rep,name=templateData,proto3" json:"templateData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// data is the list to be sent along the notification
-	Data map[string]string `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// the message must specify exactly one of token, topic, or condition
-	// see https://pkg.go.dev/firebase.google.com/go/messaging#Client.Send
-	Token     string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
-	Topic     string `protobuf:"bytes,5,opt,name=topic,proto3" json:"topic,omitempty"`
-	Condition string `protobuf:"bytes,6,opt,name=condition,proto3" json:"condition,omitempty"`
+	// ref is the consumer-defined id of the user
+	Ref       string `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
+func (x *ListUserDevicesRequest) Reset() {
+	*x = ListUserDevicesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_notification_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -382,13 +962,13 @@ func (x *Message) Reset() {
 	}
 }
 
-func (x *Message) String() string {
+func (x *ListUserDevicesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Message) ProtoMessage() {}
+func (*ListUserDevicesRequest) ProtoMessage() {}
 
-func (x *Message) ProtoReflect() protoreflect.Message {
+func (x *ListUserDevicesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_notification_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -400,85 +980,114 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListUserDevicesRequest.ProtoReflect.Descriptor instead.
+func (*ListUserDevicesRequest) Descriptor() ([]byte, []int) {
 	return file_v1_notification_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Message) GetTemplateId() string {
+func (x *ListUserDevicesRequest) GetRef() string {
 	if x != nil {
-		return x.TemplateId
+		return x.Ref
 	}
 	return ""
 }
 
-func (x *Message) GetTemplateData() map[string]string {
+func (x *ListUserDevicesRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.TemplateData
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-func (x *Message) GetData() map[string]string {
+func (x *ListUserDevicesRequest) GetPageToken() string {
 	if x != nil {
-		return x.Data
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-func (x *Message) GetToken() string {
-	if x != nil {
-		return x.Token
+type RemoveUserRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ref is the consumer-defined id of the user
+	Ref string `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+}
+
+func (x *RemoveUserRequest) Reset() {
+	*x = RemoveUserRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Message) GetTopic() string {
-	if x != nil {
-		return x.Topic
+func (x *RemoveUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveUserRequest) ProtoMessage() {}
+
+func (x *RemoveUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Message) GetCondition() string {
+// Deprecated: Use RemoveUserRequest.ProtoReflect.Descriptor instead.
+func (*RemoveUserRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RemoveUserRequest) GetRef() string {
 	if x != nil {
-		return x.Condition
+		return x.Ref
 	}
 	return ""
 }
 
-type MulticastMessage struct {
+type CleanupInstancesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TemplateId string `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
-	// templateData is used to replace dynamic values inside the
-	// FCM message configuration
-	TemplateData map[string]string `protobuf:"bytes,2,rep,name=templateData,proto3" json:"templateData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// data is the list to be sent along the notification
-	Data map[string]string `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// tokens is a list of tokens the message should be sent to
-	Tokens []string `protobuf:"bytes,4,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// token_max_age is the age of last_seen_at after which the token is removed.
+	// Defaults to the configuration of the server
+	TokenMaxAge *duration.Duration `protobuf:"bytes,1,opt,name=token_max_age,json=tokenMaxAge,proto3" json:"token_max_age,omitempty"`
+	// instance_max_age is the age of last_seen_at after which the instance is removed.
+	// Defaults to the configuration of the server
+	InstanceMaxAge *duration.Duration `protobuf:"bytes,2,opt,name=instance_max_age,json=instanceMaxAge,proto3" json:"instance_max_age,omitempty"`
+	// validate_tokens validates the remaining tokens with a dry run send
+	ValidateTokens bool `protobuf:"varint,3,opt,name=validate_tokens,json=validateTokens,proto3" json:"validate_tokens,omitempty"`
+	// dry_run only reports what would be cleaned without changing any instance
+	DryRun bool `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *MulticastMessage) Reset() {
-	*x = MulticastMessage{}
+func (x *CleanupInstancesRequest) Reset() {
+	*x = CleanupInstancesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[7]
+		mi := &file_v1_notification_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MulticastMessage) String() string {
+func (x *CleanupInstancesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MulticastMessage) ProtoMessage() {}
+func (*CleanupInstancesRequest) ProtoMessage() {}
 
-func (x *MulticastMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[7]
+func (x *CleanupInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -489,74 +1098,72 @@ func (x *MulticastMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MulticastMessage.ProtoReflect.Descriptor instead.
-func (*MulticastMessage) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CleanupInstancesRequest.ProtoReflect.Descriptor instead.
+func (*CleanupInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MulticastMessage) GetTemplateId() string {
+func (x *CleanupInstancesRequest) GetTokenMaxAge() *duration.Duration {
 	if x != nil {
-		return x.TemplateId
+		return x.TokenMaxAge
 	}
-	return ""
+	return nil
 }
 
-func (x *MulticastMessage) GetTemplateData() map[string]string {
+func (x *CleanupInstancesRequest) GetInstanceMaxAge() *duration.Duration {
 	if x != nil {
-		return x.TemplateData
+		return x.InstanceMaxAge
 	}
 	return nil
 }
 
-func (x *MulticastMessage) GetData() map[string]string {
+func (x *CleanupInstancesRequest) GetValidateTokens() bool {
 	if x != nil {
-		return x.Data
+		return x.ValidateTokens
 	}
-	return nil
+	return false
 }
 
-func (x *MulticastMessage) GetTokens() []string {
+func (x *CleanupInstancesRequest) GetDryRun() bool {
 	if x != nil {
-		return x.Tokens
+		return x.DryRun
 	}
-	return nil
+	return false
 }
 
-// ListNotificationsRequest defines a message that returns notifications
-// already sent in a descending list
-type ListNotificationsRequest struct {
+// CleanupReport contains the numbers of cleaned instances and tokens
+type CleanupReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// the filter accepts parameters using the following rule:
-	// 1) if 'instance_id' is set, use this parameter
-	// 2) if 'token' is set, use this parameter
-	// 3) if 'ref' is set, use this parameter
-	// -- parameters 1-3 are always uniquely identifying the objects
-	// 4) use labels map with the AND semantic
-	Filter    *AppInstance `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
-	PageSize  int32        `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	PageToken string       `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// scanned is the number of instances read during the cleanup
+	Scanned int32 `protobuf:"varint,1,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	// tokens_expired is the number of tokens removed due to the token_max_age
+	TokensExpired int32 `protobuf:"varint,2,opt,name=tokens_expired,json=tokensExpired,proto3" json:"tokens_expired,omitempty"`
+	// tokens_invalid is the number of tokens rejected by FCM
+	TokensInvalid int32 `protobuf:"varint,3,opt,name=tokens_invalid,json=tokensInvalid,proto3" json:"tokens_invalid,omitempty"`
+	// instances_removed is the number of instances removed due to the instance_max_age
+	InstancesRemoved int32 `protobuf:"varint,4,opt,name=instances_removed,json=instancesRemoved,proto3" json:"instances_removed,omitempty"`
 }
 
-func (x *ListNotificationsRequest) Reset() {
-	*x = ListNotificationsRequest{}
+func (x *CleanupReport) Reset() {
+	*x = CleanupReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[8]
+		mi := &file_v1_notification_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ListNotificationsRequest) String() string {
+func (x *CleanupReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListNotificationsRequest) ProtoMessage() {}
+func (*CleanupReport) ProtoMessage() {}
 
-func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[8]
+func (x *CleanupReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -567,58 +1174,65 @@ func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
-func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use CleanupReport.ProtoReflect.Descriptor instead.
+func (*CleanupReport) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ListNotificationsRequest) GetFilter() *AppInstance {
+func (x *CleanupReport) GetScanned() int32 {
 	if x != nil {
-		return x.Filter
+		return x.Scanned
 	}
-	return nil
+	return 0
 }
 
-func (x *ListNotificationsRequest) GetPageSize() int32 {
+func (x *CleanupReport) GetTokensExpired() int32 {
 	if x != nil {
-		return x.PageSize
+		return x.TokensExpired
 	}
 	return 0
 }
 
-func (x *ListNotificationsRequest) GetPageToken() string {
+func (x *CleanupReport) GetTokensInvalid() int32 {
 	if x != nil {
-		return x.PageToken
+		return x.TokensInvalid
 	}
-	return ""
+	return 0
 }
 
-type NotificationList struct {
+func (x *CleanupReport) GetInstancesRemoved() int32 {
+	if x != nil {
+		return x.InstancesRemoved
+	}
+	return 0
+}
+
+type DeduplicateTokensRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Notifications []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
-	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// dry_run only reports the duplicates without changing any instance
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 }
 
-func (x *NotificationList) Reset() {
-	*x = NotificationList{}
+func (x *DeduplicateTokensRequest) Reset() {
+	*x = DeduplicateTokensRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[9]
+		mi := &file_v1_notification_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NotificationList) String() string {
+func (x *DeduplicateTokensRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationList) ProtoMessage() {}
+func (*DeduplicateTokensRequest) ProtoMessage() {}
 
-func (x *NotificationList) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[9]
+func (x *DeduplicateTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,57 +1243,48 @@ func (x *NotificationList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationList.ProtoReflect.Descriptor instead.
-func (*NotificationList) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *NotificationList) GetNotifications() []*Notification {
-	if x != nil {
-		return x.Notifications
-	}
-	return nil
+// Deprecated: Use DeduplicateTokensRequest.ProtoReflect.Descriptor instead.
+func (*DeduplicateTokensRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *NotificationList) GetNextPageToken() string {
+func (x *DeduplicateTokensRequest) GetDryRun() bool {
 	if x != nil {
-		return x.NextPageToken
+		return x.DryRun
 	}
-	return ""
+	return false
 }
 
-// Notification is message generated by the system for a specific user
-type Notification struct {
+type DeduplicateTokensReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Instance *AppInstance      `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty"`
-	Data     map[string]string `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// message is the object containing the configuration of an FCM message
-	// see https://pkg.go.dev/firebase.google.com/go/messaging#Message
-	// this object is already populated from the template and corresponds to
-	// what was sent to the FCM API
-	Message *FCMMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// scanned is the number of instances with a token that were read
+	Scanned int32 `protobuf:"varint,1,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	// duplicate_tokens is the number of tokens held by more than one instance
+	DuplicateTokens int32 `protobuf:"varint,2,opt,name=duplicate_tokens,json=duplicateTokens,proto3" json:"duplicate_tokens,omitempty"`
+	// tokens_removed is the number of instances the duplicate token was removed from
+	TokensRemoved int32 `protobuf:"varint,3,opt,name=tokens_removed,json=tokensRemoved,proto3" json:"tokens_removed,omitempty"`
 }
 
-func (x *Notification) Reset() {
-	*x = Notification{}
+func (x *DeduplicateTokensReport) Reset() {
+	*x = DeduplicateTokensReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[10]
+		mi := &file_v1_notification_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Notification) String() string {
+func (x *DeduplicateTokensReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Notification) ProtoMessage() {}
+func (*DeduplicateTokensReport) ProtoMessage() {}
 
-func (x *Notification) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[10]
+func (x *DeduplicateTokensReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,60 +1295,62 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
-func (*Notification) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use DeduplicateTokensReport.ProtoReflect.Descriptor instead.
+func (*DeduplicateTokensReport) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Notification) GetInstance() *AppInstance {
+func (x *DeduplicateTokensReport) GetScanned() int32 {
 	if x != nil {
-		return x.Instance
+		return x.Scanned
 	}
-	return nil
+	return 0
 }
 
-func (x *Notification) GetData() map[string]string {
+func (x *DeduplicateTokensReport) GetDuplicateTokens() int32 {
 	if x != nil {
-		return x.Data
+		return x.DuplicateTokens
 	}
-	return nil
+	return 0
 }
 
-func (x *Notification) GetMessage() *FCMMessage {
+func (x *DeduplicateTokensReport) GetTokensRemoved() int32 {
 	if x != nil {
-		return x.Message
+		return x.TokensRemoved
 	}
-	return nil
+	return 0
 }
 
-// NotificationConfig is the object of a configuration file parsed from the
-// remote config. It contains all message templates
-type NotificationConfig struct {
+type ImportReport struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// @inject_tag: firestore:"messages,omitempty"
-	Messages []*MessageTemplate `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty" firestore:"messages,omitempty"`
+	// imported is the number of written instances
+	Imported int64 `protobuf:"varint,1,opt,name=imported,proto3" json:"imported,omitempty"`
+	// failed is the number of skipped instances
+	Failed int64 `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	// errors are the errors of the skipped instances, limited to the first 1000
+	Errors []*ImportError `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (x *NotificationConfig) Reset() {
-	*x = NotificationConfig{}
+func (x *ImportReport) Reset() {
+	*x = ImportReport{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[11]
+		mi := &file_v1_notification_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NotificationConfig) String() string {
+func (x *ImportReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationConfig) ProtoMessage() {}
+func (*ImportReport) ProtoMessage() {}
 
-func (x *NotificationConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[11]
+func (x *ImportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,52 +1361,60 @@ func (x *NotificationConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationConfig.ProtoReflect.Descriptor instead.
-func (*NotificationConfig) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ImportReport.ProtoReflect.Descriptor instead.
+func (*ImportReport) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *NotificationConfig) GetMessages() []*MessageTemplate {
+func (x *ImportReport) GetImported() int64 {
 	if x != nil {
-		return x.Messages
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportReport) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportReport) GetErrors() []*ImportError {
+	if x != nil {
+		return x.Errors
 	}
 	return nil
 }
 
-type MessageTemplate struct {
+type ImportError struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is a unique key under which the template is referenced
-	// this field is other referenced as 'instance_id'
-	// @inject_tag: firestore:"id,omitempty"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" firestore:"id,omitempty"`
-	// message is the object containing the configuration of an FCM message
-	// see https://pkg.go.dev/firebase.google.com/go/messaging#Message
-	// this object will be parsed from the configuration file directly into the
-	// FCM message specification
-	// @inject_tag: firestore:"message,omitempty"
-	Message map[string]*any.Any `protobuf:"bytes,2,rep,name=message,proto3" json:"message,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" firestore:"message,omitempty"`
+	// row is the zero-based position of the instance in the import
+	Row        int64  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	InstanceId string `protobuf:"bytes,2,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *MessageTemplate) Reset() {
-	*x = MessageTemplate{}
+func (x *ImportError) Reset() {
+	*x = ImportError{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[12]
+		mi := &file_v1_notification_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessageTemplate) String() string {
+func (x *ImportError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageTemplate) ProtoMessage() {}
+func (*ImportError) ProtoMessage() {}
 
-func (x *MessageTemplate) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[12]
+func (x *ImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -810,67 +1425,58 @@ func (x *MessageTemplate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageTemplate.ProtoReflect.Descriptor instead.
-func (*MessageTemplate) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use ImportError.ProtoReflect.Descriptor instead.
+func (*ImportError) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *MessageTemplate) GetId() string {
+func (x *ImportError) GetRow() int64 {
 	if x != nil {
-		return x.Id
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportError) GetInstanceId() string {
+	if x != nil {
+		return x.InstanceId
 	}
 	return ""
 }
 
-func (x *MessageTemplate) GetMessage() map[string]*any.Any {
+func (x *ImportError) GetReason() string {
 	if x != nil {
-		return x.Message
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-type FCMMessage struct {
+type ExportInstancesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// @inject_tag: firestore:"data,omitempty"
-	Data map[string]string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" firestore:"data,omitempty"`
-	// @inject_tag: firestore:"notification,omitempty"
-	Notification *FCMNotification `protobuf:"bytes,2,opt,name=notification,proto3" json:"notification,omitempty" firestore:"notification,omitempty"`
-	// @inject_tag: firestore:"android,omitempty"
-	Android *FCMAndroid `protobuf:"bytes,3,opt,name=android,proto3" json:"android,omitempty" firestore:"android,omitempty"`
-	// @inject_tag: firestore:"webpush,omitempty"
-	Webpush *FCMWebpush `protobuf:"bytes,4,opt,name=webpush,proto3" json:"webpush,omitempty" firestore:"webpush,omitempty"`
-	// @inject_tag: firestore:"apns,omitempty"
-	Apns *FCMAPNS `protobuf:"bytes,5,opt,name=apns,proto3" json:"apns,omitempty" firestore:"apns,omitempty"`
-	// @inject_tag: firestore:"fcmOptions,omitempty"
-	FcmOptions *FCMOptions `protobuf:"bytes,6,opt,name=fcm_options,json=fcmOptions,proto3" json:"fcm_options,omitempty" firestore:"fcmOptions,omitempty"`
-	// @inject_tag: firestore:"token,omitempty"
-	Token string `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty" firestore:"token,omitempty"`
-	// @inject_tag: firestore:"topic,omitempty"
-	Topic string `protobuf:"bytes,8,opt,name=topic,proto3" json:"topic,omitempty" firestore:"topic,omitempty"`
-	// @inject_tag: firestore:"condition,omitempty"
-	Condition string `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition,omitempty" firestore:"condition,omitempty"`
+	// start_after resumes the export after the instance_id
+	StartAfter string `protobuf:"bytes,1,opt,name=start_after,json=startAfter,proto3" json:"start_after,omitempty"`
 }
 
-func (x *FCMMessage) Reset() {
-	*x = FCMMessage{}
+func (x *ExportInstancesRequest) Reset() {
+	*x = ExportInstancesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[13]
+		mi := &file_v1_notification_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FCMMessage) String() string {
+func (x *ExportInstancesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FCMMessage) ProtoMessage() {}
+func (*ExportInstancesRequest) ProtoMessage() {}
 
-func (x *FCMMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[13]
+func (x *ExportInstancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -881,104 +1487,98 @@ func (x *FCMMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FCMMessage.ProtoReflect.Descriptor instead.
-func (*FCMMessage) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use ExportInstancesRequest.ProtoReflect.Descriptor instead.
+func (*ExportInstancesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *FCMMessage) GetData() map[string]string {
+func (x *ExportInstancesRequest) GetStartAfter() string {
 	if x != nil {
-		return x.Data
+		return x.StartAfter
 	}
-	return nil
+	return ""
 }
 
-func (x *FCMMessage) GetNotification() *FCMNotification {
-	if x != nil {
-		return x.Notification
-	}
-	return nil
-}
+type AppInstanceList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *FCMMessage) GetAndroid() *FCMAndroid {
-	if x != nil {
-		return x.Android
-	}
-	return nil
+	Instances     []*AppInstance `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	NextPageToken string         `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 }
 
-func (x *FCMMessage) GetWebpush() *FCMWebpush {
-	if x != nil {
-		return x.Webpush
+func (x *AppInstanceList) Reset() {
+	*x = AppInstanceList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *FCMMessage) GetApns() *FCMAPNS {
-	if x != nil {
-		return x.Apns
-	}
-	return nil
+func (x *AppInstanceList) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FCMMessage) GetFcmOptions() *FCMOptions {
-	if x != nil {
-		return x.FcmOptions
+func (*AppInstanceList) ProtoMessage() {}
+
+func (x *AppInstanceList) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *FCMMessage) GetToken() string {
-	if x != nil {
-		return x.Token
-	}
-	return ""
+// Deprecated: Use AppInstanceList.ProtoReflect.Descriptor instead.
+func (*AppInstanceList) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *FCMMessage) GetTopic() string {
+func (x *AppInstanceList) GetInstances() []*AppInstance {
 	if x != nil {
-		return x.Topic
+		return x.Instances
 	}
-	return ""
+	return nil
 }
 
-func (x *FCMMessage) GetCondition() string {
+func (x *AppInstanceList) GetNextPageToken() string {
 	if x != nil {
-		return x.Condition
+		return x.NextPageToken
 	}
 	return ""
 }
 
-type FCMNotification struct {
+type SendRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// @inject_tag: firestore:"title,omitempty"
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" firestore:"title,omitempty"`
-	// @inject_tag: firestore:"body,omitempty"
-	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty" firestore:"body,omitempty"`
-	// @inject_tag: firestore:"imageURL,omitempty"
-	ImageUrl string `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty" firestore:"imageURL,omitempty"`
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *FCMNotification) Reset() {
-	*x = FCMNotification{}
+func (x *SendRequest) Reset() {
+	*x = SendRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[14]
+		mi := &file_v1_notification_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FCMNotification) String() string {
+func (x *SendRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FCMNotification) ProtoMessage() {}
+func (*SendRequest) ProtoMessage() {}
 
-func (x *FCMNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[14]
+func (x *SendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -989,64 +1589,47 @@ func (x *FCMNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FCMNotification.ProtoReflect.Descriptor instead.
-func (*FCMNotification) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *FCMNotification) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
-
-func (x *FCMNotification) GetBody() string {
-	if x != nil {
-		return x.Body
-	}
-	return ""
+// Deprecated: Use SendRequest.ProtoReflect.Descriptor instead.
+func (*SendRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *FCMNotification) GetImageUrl() string {
+func (x *SendRequest) GetMessage() *Message {
 	if x != nil {
-		return x.ImageUrl
+		return x.Message
 	}
-	return ""
+	return nil
 }
 
-// see https://pkg.go.dev/firebase.google.com/go/messaging#AndroidConfig
-type FCMAndroid struct {
+type SendAllRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CollapseKey           string                  `protobuf:"bytes,1,opt,name=collapse_key,json=collapseKey,proto3" json:"collapse_key,omitempty"`
-	Priority              string                  `protobuf:"bytes,2,opt,name=priority,proto3" json:"priority,omitempty"`
-	Ttl                   *timestamp.Timestamp    `protobuf:"bytes,3,opt,name=ttl,proto3" json:"ttl,omitempty"`
-	RestrictedPackageName string                  `protobuf:"bytes,4,opt,name=restricted_package_name,json=restrictedPackageName,proto3" json:"restricted_package_name,omitempty"`
-	Data                  map[string]string       `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Notification          *FCMAndroidNotification `protobuf:"bytes,6,opt,name=notification,proto3" json:"notification,omitempty"`
-	FcmOptions            *FCMAndroidOptions      `protobuf:"bytes,7,opt,name=fcm_options,json=fcmOptions,proto3" json:"fcm_options,omitempty"`
+	Messages []*Message `protobuf:"bytes,2,rep,name=messages,proto3" json:"messages,omitempty"`
+	// idempotency_key deduplicates retried requests. A request with an already seen key
+	// is not sent again and the original result is returned instead.
+	// Keys of the messages deduplicate the messages individually
+	IdempotencyKey string `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *FCMAndroid) Reset() {
-	*x = FCMAndroid{}
+func (x *SendAllRequest) Reset() {
+	*x = SendAllRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[15]
+		mi := &file_v1_notification_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FCMAndroid) String() string {
+func (x *SendAllRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FCMAndroid) ProtoMessage() {}
+func (*SendAllRequest) ProtoMessage() {}
 
-func (x *FCMAndroid) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[15]
+func (x *SendAllRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,111 +1640,126 @@ func (x *FCMAndroid) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FCMAndroid.ProtoReflect.Descriptor instead.
-func (*FCMAndroid) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SendAllRequest.ProtoReflect.Descriptor instead.
+func (*SendAllRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *FCMAndroid) GetCollapseKey() string {
+func (x *SendAllRequest) GetMessages() []*Message {
 	if x != nil {
-		return x.CollapseKey
+		return x.Messages
 	}
-	return ""
+	return nil
 }
 
-func (x *FCMAndroid) GetPriority() string {
+func (x *SendAllRequest) GetIdempotencyKey() string {
 	if x != nil {
-		return x.Priority
+		return x.IdempotencyKey
 	}
 	return ""
 }
 
-func (x *FCMAndroid) GetTtl() *timestamp.Timestamp {
-	if x != nil {
-		return x.Ttl
-	}
-	return nil
-}
+type SendMulticastRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *FCMAndroid) GetRestrictedPackageName() string {
-	if x != nil {
-		return x.RestrictedPackageName
-	}
-	return ""
+	Message *MulticastMessage `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *FCMAndroid) GetData() map[string]string {
-	if x != nil {
-		return x.Data
+func (x *SendMulticastRequest) Reset() {
+	*x = SendMulticastRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *FCMAndroid) GetNotification() *FCMAndroidNotification {
-	if x != nil {
-		return x.Notification
-	}
-	return nil
+func (x *SendMulticastRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *FCMAndroid) GetFcmOptions() *FCMAndroidOptions {
-	if x != nil {
-		return x.FcmOptions
-	}
-	return nil
-}
+func (*SendMulticastRequest) ProtoMessage() {}
 
-// see https://pkg.go.dev/firebase.google.com/go/messaging#AndroidNotification
-type FCMAndroidNotification struct {
+func (x *SendMulticastRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendMulticastRequest.ProtoReflect.Descriptor instead.
+func (*SendMulticastRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SendMulticastRequest) GetMessage() *MulticastMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type Message struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// @inject_tag: firestore"title,omitempty"
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	// @inject_tag: firestore"body,omitempty"
-	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-	// @inject_tag: firestore"icon,omitempty"
-	Icon string `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
-	// @inject_tag: firestore"color,omitempty"
-	Color string `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
-	// @inject_tag: firestore"sound,omitempty"
-	Sound string `protobuf:"bytes,5,opt,name=sound,proto3" json:"sound,omitempty"`
-	// @inject_tag: firestore"tag,omitempty"
-	Tag string `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
-	// @inject_tag: firestore"click_action,omitempty"
-	ClickAction string `protobuf:"bytes,7,opt,name=click_action,json=clickAction,proto3" json:"click_action,omitempty"`
-	// @inject_tag: firestore"body_loc_key,omitempty"
-	BodyLocKey string `protobuf:"bytes,8,opt,name=body_loc_key,json=bodyLocKey,proto3" json:"body_loc_key,omitempty"`
-	// @inject_tag: firestore"body_loc_args,omitempty"
-	BodyLocArgs []string `protobuf:"bytes,9,rep,name=body_loc_args,json=bodyLocArgs,proto3" json:"body_loc_args,omitempty"`
-	// @inject_tag: firestore"title_loc_key,omitempty"
-	TitleLocKey string `protobuf:"bytes,10,opt,name=title_loc_key,json=titleLocKey,proto3" json:"title_loc_key,omitempty"`
-	// @inject_tag: firestore"title_loc_args,omitempty"
-	TitleLocArgs []string `protobuf:"bytes,11,rep,name=title_loc_args,json=titleLocArgs,proto3" json:"title_loc_args,omitempty"`
-	// @inject_tag: firestore"channel_id,omitempty"
-	ChannelId string `protobuf:"bytes,12,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
-	// @inject_tag: firestore:"image,omitempty" json:"image,omitempty"
-	ImageUrl string `protobuf:"bytes,13,opt,name=image_url,json=imageUrl,proto3" json:"image,omitempty" firestore:"image,omitempty"`
+	// template_id is the id of the MessageTemplate used to render the notification
+	TemplateId string `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// templateData is used to replace dynamic values inside the
+	// FCM message configuration
+	TemplateData map[string]string `protobuf:"bytes,2,rep,name=templateData,proto3" json:"templateData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// data is the list to be sent along the notification
+	Data map[string]string `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// the message must specify exactly one of token, topic, condition, ref, or audience
+	// see https://pkg.go.dev/firebase.google.com/go/messaging#Client.Send
+	Token     string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	Topic     string `protobuf:"bytes,5,opt,name=topic,proto3" json:"topic,omitempty"`
+	Condition string `protobuf:"bytes,6,opt,name=condition,proto3" json:"condition,omitempty"`
+	// ref sends the message to all instances of the ref (e.g. the user ID) with a token
+	Ref string `protobuf:"bytes,8,opt,name=ref,proto3" json:"ref,omitempty"`
+	// send_at schedules the message to be sent at the time instead of immediately.
+	// The id of the scheduled notification is the hex SHA-256 of the idempotency_key if set
+	SendAt *timestamp.Timestamp `protobuf:"bytes,10,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	// critical messages are delivered regardless of the quiet hours
+	Critical bool `protobuf:"varint,11,opt,name=critical,proto3" json:"critical,omitempty"`
+	// audience sends the message to all instances with a token matching the audience
+	// expression, e.g. ref in ("u1", "u2") AND labels.plan = "pro" AND platform = "ios".
+	// Expressions combine comparisons with AND, OR, NOT and parentheses. Fields are
+	// instance_id, token, ref, platform, app_version, os_version, sdk_version, timezone, labels.<key>,
+	// and the created_at, updated_at, last_seen_at and token_updated_at timestamps.
+	// Operators are =, !=, IN and NOT IN, and <, <=, >, >= for the timestamps, which
+	// are compared to RFC 3339 values
+	Audience string `protobuf:"bytes,9,opt,name=audience,proto3" json:"audience,omitempty"`
+	// idempotency_key deduplicates retried messages. A message with an already seen key
+	// is not sent again and the original result is returned instead
+	IdempotencyKey string `protobuf:"bytes,7,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
 }
 
-func (x *FCMAndroidNotification) Reset() {
-	*x = FCMAndroidNotification{}
+func (x *Message) Reset() {
+	*x = Message{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[16]
+		mi := &file_v1_notification_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FCMAndroidNotification) String() string {
+func (x *Message) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FCMAndroidNotification) ProtoMessage() {}
+func (*Message) ProtoMessage() {}
 
-func (x *FCMAndroidNotification) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[16]
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,129 +1770,139 @@ func (x *FCMAndroidNotification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FCMAndroidNotification.ProtoReflect.Descriptor instead.
-func (*FCMAndroidNotification) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *FCMAndroidNotification) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *FCMAndroidNotification) GetBody() string {
+func (x *Message) GetTemplateId() string {
 	if x != nil {
-		return x.Body
+		return x.TemplateId
 	}
 	return ""
 }
 
-func (x *FCMAndroidNotification) GetIcon() string {
+func (x *Message) GetTemplateData() map[string]string {
 	if x != nil {
-		return x.Icon
+		return x.TemplateData
 	}
-	return ""
+	return nil
 }
 
-func (x *FCMAndroidNotification) GetColor() string {
+func (x *Message) GetData() map[string]string {
 	if x != nil {
-		return x.Color
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *FCMAndroidNotification) GetSound() string {
+func (x *Message) GetToken() string {
 	if x != nil {
-		return x.Sound
+		return x.Token
 	}
 	return ""
 }
 
-func (x *FCMAndroidNotification) GetTag() string {
+func (x *Message) GetTopic() string {
 	if x != nil {
-		return x.Tag
+		return x.Topic
 	}
 	return ""
 }
 
-func (x *FCMAndroidNotification) GetClickAction() string {
+func (x *Message) GetCondition() string {
 	if x != nil {
-		return x.ClickAction
+		return x.Condition
 	}
 	return ""
 }
 
-func (x *FCMAndroidNotification) GetBodyLocKey() string {
+func (x *Message) GetRef() string {
 	if x != nil {
-		return x.BodyLocKey
+		return x.Ref
 	}
 	return ""
 }
 
-func (x *FCMAndroidNotification) GetBodyLocArgs() []string {
+func (x *Message) GetSendAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.BodyLocArgs
+		return x.SendAt
 	}
 	return nil
 }
 
-func (x *FCMAndroidNotification) GetTitleLocKey() string {
-	if x != nil {
-		return x.TitleLocKey
-	}
-	return ""
-}
-
-func (x *FCMAndroidNotification) GetTitleLocArgs() []string {
+func (x *Message) GetCritical() bool {
 	if x != nil {
-		return x.TitleLocArgs
+		return x.Critical
 	}
-	return nil
+	return false
 }
 
-func (x *FCMAndroidNotification) GetChannelId() string {
+func (x *Message) GetAudience() string {
 	if x != nil {
-		return x.ChannelId
+		return x.Audience
 	}
 	return ""
 }
 
-func (x *FCMAndroidNotification) GetImageUrl() string {
+func (x *Message) GetIdempotencyKey() string {
 	if x != nil {
-		return x.ImageUrl
+		return x.IdempotencyKey
 	}
 	return ""
 }
 
-// see https://pkg.go.dev/firebase.google.com/go/messaging#AndroidFCMOptions
-type FCMAndroidOptions struct {
+type MulticastMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// @inject_tag: firestore:"analyticsLabel,omitempty"
-	AnalyticsLabel string `protobuf:"bytes,1,opt,name=analytics_label,json=analyticsLabel,proto3" json:"analytics_label,omitempty" firestore:"analyticsLabel,omitempty"`
+	// template_id is the id of the MessageTemplate used to render the notification
+	TemplateId string `protobuf:"bytes,1,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty"`
+	// templateData is used to replace dynamic values inside the
+	// FCM message configuration
+	TemplateData map[string]string `protobuf:"bytes,2,rep,name=templateData,proto3" json:"templateData,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// data is the list to be sent along the notification
+	Data map[string]string `protobuf:"bytes,3,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// tokens is a list of tokens the message should be sent to.
+	// The message must specify exactly one of tokens, audience, or segment_id
+	Tokens []string `protobuf:"bytes,4,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	// idempotency_key deduplicates retried messages. A message with an already seen key
+	// is not sent again and the original result is returned instead
+	IdempotencyKey string `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// audience sends the message to all instances with a token matching the audience
+	// expression, see Message.audience
+	Audience string `protobuf:"bytes,6,opt,name=audience,proto3" json:"audience,omitempty"`
+	// segment_id sends the message to the audience of the segment, resolved at the time
+	// of the send
+	SegmentId string `protobuf:"bytes,7,opt,name=segment_id,json=segmentId,proto3" json:"segment_id,omitempty"`
+	// send_at schedules the message to be sent at the time instead of immediately.
+	// The id of the scheduled notification is the hex SHA-256 of the idempotency_key if set
+	SendAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty"`
+	// local_time schedules the message to be sent at the local time of each instance
+	// of the audience or the segment. Instances are grouped by their timezone
+	LocalTime *LocalTime `protobuf:"bytes,9,opt,name=local_time,json=localTime,proto3" json:"local_time,omitempty"`
+	// critical messages are delivered regardless of the quiet hours
+	Critical bool `protobuf:"varint,10,opt,name=critical,proto3" json:"critical,omitempty"`
 }
 
-func (x *FCMAndroidOptions) Reset() {
-	*x = FCMAndroidOptions{}
+func (x *MulticastMessage) Reset() {
+	*x = MulticastMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[17]
+		mi := &file_v1_notification_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FCMAndroidOptions) String() string {
+func (x *MulticastMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FCMAndroidOptions) ProtoMessage() {}
+func (*MulticastMessage) ProtoMessage() {}
 
-func (x *FCMAndroidOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[17]
+func (x *MulticastMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1305,41 +1913,116 @@ func (x *FCMAndroidOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FCMAndroidOptions.ProtoReflect.Descriptor instead.
-func (*FCMAndroidOptions) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use MulticastMessage.ProtoReflect.Descriptor instead.
+func (*MulticastMessage) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *FCMAndroidOptions) GetAnalyticsLabel() string {
+func (x *MulticastMessage) GetTemplateId() string {
 	if x != nil {
-		return x.AnalyticsLabel
+		return x.TemplateId
 	}
 	return ""
 }
 
-type FCMWebpush struct {
+func (x *MulticastMessage) GetTemplateData() map[string]string {
+	if x != nil {
+		return x.TemplateData
+	}
+	return nil
+}
+
+func (x *MulticastMessage) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *MulticastMessage) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *MulticastMessage) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *MulticastMessage) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *MulticastMessage) GetSegmentId() string {
+	if x != nil {
+		return x.SegmentId
+	}
+	return ""
+}
+
+func (x *MulticastMessage) GetSendAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+func (x *MulticastMessage) GetLocalTime() *LocalTime {
+	if x != nil {
+		return x.LocalTime
+	}
+	return nil
+}
+
+func (x *MulticastMessage) GetCritical() bool {
+	if x != nil {
+		return x.Critical
+	}
+	return false
+}
+
+// LocalTime is a wall-clock time in the timezones of the instances. Local times skipped
+// by a daylight saving transition are shifted forward by the length of the transition
+// (e.g. 2:30 becomes 3:30) and repeated local times are sent at their first occurrence
+type LocalTime struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Hour   int32 `protobuf:"varint,1,opt,name=hour,proto3" json:"hour,omitempty"`
+	Minute int32 `protobuf:"varint,2,opt,name=minute,proto3" json:"minute,omitempty"`
+	// date is the local date in the YYYY-MM-DD format. If empty, the next occurrence
+	// of the local time in each timezone is used. Timezones in which the local time
+	// on the date already passed are skipped
+	Date string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// default_timezone is used for instances without a timezone. Defaults to UTC
+	DefaultTimezone string `protobuf:"bytes,4,opt,name=default_timezone,json=defaultTimezone,proto3" json:"default_timezone,omitempty"`
 }
 
-func (x *FCMWebpush) Reset() {
-	*x = FCMWebpush{}
+func (x *LocalTime) Reset() {
+	*x = LocalTime{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[18]
+		mi := &file_v1_notification_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FCMWebpush) String() string {
+func (x *LocalTime) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FCMWebpush) ProtoMessage() {}
+func (*LocalTime) ProtoMessage() {}
 
-func (x *FCMWebpush) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[18]
+func (x *LocalTime) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1350,479 +2033,4420 @@ func (x *FCMWebpush) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FCMWebpush.ProtoReflect.Descriptor instead.
-func (*FCMWebpush) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use LocalTime.ProtoReflect.Descriptor instead.
+func (*LocalTime) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *LocalTime) GetHour() int32 {
+	if x != nil {
+		return x.Hour
+	}
+	return 0
+}
+
+func (x *LocalTime) GetMinute() int32 {
+	if x != nil {
+		return x.Minute
+	}
+	return 0
+}
+
+func (x *LocalTime) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
 }
 
-type FCMAPNS struct {
+func (x *LocalTime) GetDefaultTimezone() string {
+	if x != nil {
+		return x.DefaultTimezone
+	}
+	return ""
+}
+
+type CountAudienceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// audience is the audience expression, see Message.audience
+	Audience string `protobuf:"bytes,1,opt,name=audience,proto3" json:"audience,omitempty"`
 }
 
-func (x *FCMAPNS) Reset() {
-	*x = FCMAPNS{}
+func (x *CountAudienceRequest) Reset() {
+	*x = CountAudienceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[19]
+		mi := &file_v1_notification_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FCMAPNS) String() string {
+func (x *CountAudienceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FCMAPNS) ProtoMessage() {}
+func (*CountAudienceRequest) ProtoMessage() {}
 
-func (x *FCMAPNS) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[19]
+func (x *CountAudienceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FCMAPNS.ProtoReflect.Descriptor instead.
-func (*FCMAPNS) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{19}
-}
-
-type FCMOptions struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-}
-
-func (x *FCMOptions) Reset() {
-	*x = FCMOptions{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_notification_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *FCMOptions) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FCMOptions) ProtoMessage() {}
-
-func (x *FCMOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_notification_proto_msgTypes[20]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountAudienceRequest.ProtoReflect.Descriptor instead.
+func (*CountAudienceRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CountAudienceRequest) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+type AudienceCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// instances is the number of instances matching the audience
+	Instances int64 `protobuf:"varint,1,opt,name=instances,proto3" json:"instances,omitempty"`
+	// tokens is the number of distinct tokens of the matching instances
+	Tokens int64 `protobuf:"varint,2,opt,name=tokens,proto3" json:"tokens,omitempty"`
+}
+
+func (x *AudienceCount) Reset() {
+	*x = AudienceCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AudienceCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudienceCount) ProtoMessage() {}
+
+func (x *AudienceCount) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudienceCount.ProtoReflect.Descriptor instead.
+func (*AudienceCount) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AudienceCount) GetInstances() int64 {
+	if x != nil {
+		return x.Instances
+	}
+	return 0
+}
+
+func (x *AudienceCount) GetTokens() int64 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+// Segment is a named audience of instances, e.g. "trial users on Android"
+type Segment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore:"-"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" firestore:"-"`
+	// @inject_tag: firestore:"name,omitempty"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" firestore:"name,omitempty"`
+	// @inject_tag: firestore:"description,omitempty"
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty" firestore:"description,omitempty"`
+	// audience is the audience expression of the segment, see Message.audience
+	// @inject_tag: firestore:"audience,omitempty"
+	Audience string `protobuf:"bytes,4,opt,name=audience,proto3" json:"audience,omitempty" firestore:"audience,omitempty"`
+	// @inject_tag: firestore:"createdAt,omitempty"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" firestore:"createdAt,omitempty"`
+}
+
+func (x *Segment) Reset() {
+	*x = Segment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Segment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Segment) ProtoMessage() {}
+
+func (x *Segment) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Segment.ProtoReflect.Descriptor instead.
+func (*Segment) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Segment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Segment) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Segment) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Segment) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *Segment) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type ListSegmentsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListSegmentsRequest) Reset() {
+	*x = ListSegmentsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSegmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSegmentsRequest) ProtoMessage() {}
+
+func (x *ListSegmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSegmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListSegmentsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListSegmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSegmentsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SegmentList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Segments      []*Segment `protobuf:"bytes,1,rep,name=segments,proto3" json:"segments,omitempty"`
+	NextPageToken string     `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *SegmentList) Reset() {
+	*x = SegmentList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SegmentList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentList) ProtoMessage() {}
+
+func (x *SegmentList) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentList.ProtoReflect.Descriptor instead.
+func (*SegmentList) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SegmentList) GetSegments() []*Segment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+func (x *SegmentList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeleteSegmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteSegmentRequest) Reset() {
+	*x = DeleteSegmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSegmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSegmentRequest) ProtoMessage() {}
+
+func (x *DeleteSegmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSegmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSegmentRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteSegmentRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Preferences are the consents of a ref to the template categories
+type Preferences struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore:"ref,omitempty"
+	Ref string `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty" firestore:"ref,omitempty"`
+	// categories are the preferences of the template categories, e.g. marketing
+	// @inject_tag: firestore:"categories,omitempty"
+	Categories map[string]*CategoryPreferences `protobuf:"bytes,2,rep,name=categories,proto3" json:"categories,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" firestore:"categories,omitempty"`
+	// @inject_tag: firestore:"updatedAt,omitempty"
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty" firestore:"updatedAt,omitempty"`
+}
+
+func (x *Preferences) Reset() {
+	*x = Preferences{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Preferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preferences) ProtoMessage() {}
+
+func (x *Preferences) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preferences.ProtoReflect.Descriptor instead.
+func (*Preferences) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Preferences) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Preferences) GetCategories() map[string]*CategoryPreferences {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *Preferences) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CategoryPreferences struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore:"consent,omitempty"
+	Consent Consent `protobuf:"varint,1,opt,name=consent,proto3,enum=fcmcompanion.v1.Consent" json:"consent,omitempty" firestore:"consent,omitempty"`
+	// channels override the consent of the category for the platforms of the instances,
+	// keyed by the lowercase platform, e.g. ios
+	// @inject_tag: firestore:"channels,omitempty"
+	Channels map[string]Consent `protobuf:"bytes,2,rep,name=channels,proto3" json:"channels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=fcmcompanion.v1.Consent" firestore:"channels,omitempty"`
+}
+
+func (x *CategoryPreferences) Reset() {
+	*x = CategoryPreferences{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CategoryPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryPreferences) ProtoMessage() {}
+
+func (x *CategoryPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryPreferences.ProtoReflect.Descriptor instead.
+func (*CategoryPreferences) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CategoryPreferences) GetConsent() Consent {
+	if x != nil {
+		return x.Consent
+	}
+	return Consent_CONSENT_UNSPECIFIED
+}
+
+func (x *CategoryPreferences) GetChannels() map[string]Consent {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type GetPreferencesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ref string `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+}
+
+func (x *GetPreferencesRequest) Reset() {
+	*x = GetPreferencesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPreferencesRequest) ProtoMessage() {}
+
+func (x *GetPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetPreferencesRequest) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+// ScheduledNotification is a request stored to be sent at its send_at time
+type ScheduledNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore:"-"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" firestore:"-"`
+	// method is the name of the RPC the payload is sent to, e.g. Send
+	// @inject_tag: firestore:"method,omitempty"
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty" firestore:"method,omitempty"`
+	// payload is the JSON body of the request
+	// @inject_tag: firestore:"payload,omitempty"
+	Payload string `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty" firestore:"payload,omitempty"`
+	// @inject_tag: firestore:"sendAt,omitempty"
+	SendAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=send_at,json=sendAt,proto3" json:"send_at,omitempty" firestore:"sendAt,omitempty"`
+	// @inject_tag: firestore:"state,omitempty"
+	State ScheduledNotification_State `protobuf:"varint,5,opt,name=state,proto3,enum=fcmcompanion.v1.ScheduledNotification_State" json:"state,omitempty" firestore:"state,omitempty"`
+	// attempts is the number of attempts to send the notification
+	// @inject_tag: firestore:"attempts,omitempty"
+	Attempts int32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty" firestore:"attempts,omitempty"`
+	// last_error is the error of the last failed attempt
+	// @inject_tag: firestore:"lastError,omitempty"
+	LastError string `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty" firestore:"lastError,omitempty"`
+	// @inject_tag: firestore:"createdAt,omitempty"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" firestore:"createdAt,omitempty"`
+	// due_at is the time the notification is processed next. It's either the send_at,
+	// the time of a retry, or the end of the lease of the replica sending it
+	// @inject_tag: firestore:"dueAt,omitempty"
+	DueAt *timestamp.Timestamp `protobuf:"bytes,9,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty" firestore:"dueAt,omitempty"`
+	// refs and tokens are the recipients of the payload, so the notifications of
+	// removed users can be found
+	// @inject_tag: firestore:"refs,omitempty"
+	Refs []string `protobuf:"bytes,10,rep,name=refs,proto3" json:"refs,omitempty" firestore:"refs,omitempty"`
+	// @inject_tag: firestore:"tokens,omitempty"
+	Tokens []string `protobuf:"bytes,11,rep,name=tokens,proto3" json:"tokens,omitempty" firestore:"tokens,omitempty"`
+}
+
+func (x *ScheduledNotification) Reset() {
+	*x = ScheduledNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledNotification) ProtoMessage() {}
+
+func (x *ScheduledNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledNotification.ProtoReflect.Descriptor instead.
+func (*ScheduledNotification) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ScheduledNotification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ScheduledNotification) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ScheduledNotification) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *ScheduledNotification) GetSendAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.SendAt
+	}
+	return nil
+}
+
+func (x *ScheduledNotification) GetState() ScheduledNotification_State {
+	if x != nil {
+		return x.State
+	}
+	return ScheduledNotification_STATE_UNSPECIFIED
+}
+
+func (x *ScheduledNotification) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *ScheduledNotification) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ScheduledNotification) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ScheduledNotification) GetDueAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *ScheduledNotification) GetRefs() []string {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+func (x *ScheduledNotification) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type ListScheduledRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// state returns only the notifications in the state if set
+	State     ScheduledNotification_State `protobuf:"varint,1,opt,name=state,proto3,enum=fcmcompanion.v1.ScheduledNotification_State" json:"state,omitempty"`
+	PageSize  int32                       `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                      `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListScheduledRequest) Reset() {
+	*x = ListScheduledRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListScheduledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledRequest) ProtoMessage() {}
+
+func (x *ListScheduledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListScheduledRequest) GetState() ScheduledNotification_State {
+	if x != nil {
+		return x.State
+	}
+	return ScheduledNotification_STATE_UNSPECIFIED
+}
+
+func (x *ListScheduledRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListScheduledRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ScheduledNotificationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notifications []*ScheduledNotification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextPageToken string                   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ScheduledNotificationList) Reset() {
+	*x = ScheduledNotificationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduledNotificationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledNotificationList) ProtoMessage() {}
+
+func (x *ScheduledNotificationList) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledNotificationList.ProtoReflect.Descriptor instead.
+func (*ScheduledNotificationList) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ScheduledNotificationList) GetNotifications() []*ScheduledNotification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *ScheduledNotificationList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CancelScheduledRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *CancelScheduledRequest) Reset() {
+	*x = CancelScheduledRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelScheduledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelScheduledRequest) ProtoMessage() {}
+
+func (x *CancelScheduledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelScheduledRequest.ProtoReflect.Descriptor instead.
+func (*CancelScheduledRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CancelScheduledRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// ListNotificationsRequest defines a message that returns notifications
+// already sent in a descending list
+type ListNotificationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the filter accepts parameters using the following rule:
+	// 1) if 'instance_id' is set, use this parameter
+	// 2) if 'token' is set, use this parameter
+	// 3) if 'ref' is set, use this parameter
+	// -- parameters 1-3 are always uniquely identifying the objects
+	// 4) labels are not supported, as the history doesn't store the labels
+	Filter *AppInstance `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// state returns only notifications in the state
+	State     Notification_State `protobuf:"varint,2,opt,name=state,proto3,enum=fcmcompanion.v1.Notification_State" json:"state,omitempty"`
+	PageSize  int32              `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string             `protobuf:"bytes,11,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListNotificationsRequest) Reset() {
+	*x = ListNotificationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListNotificationsRequest) ProtoMessage() {}
+
+func (x *ListNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListNotificationsRequest) GetFilter() *AppInstance {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListNotificationsRequest) GetState() Notification_State {
+	if x != nil {
+		return x.State
+	}
+	return Notification_STATE_UNSPECIFIED
+}
+
+func (x *ListNotificationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListNotificationsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type NotificationList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notifications []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextPageToken string          `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *NotificationList) Reset() {
+	*x = NotificationList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationList) ProtoMessage() {}
+
+func (x *NotificationList) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationList.ProtoReflect.Descriptor instead.
+func (*NotificationList) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *NotificationList) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *NotificationList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Notification is message generated by the system for a specific user
+type Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore:"instance,omitempty"
+	Instance *AppInstance `protobuf:"bytes,1,opt,name=instance,proto3" json:"instance,omitempty" firestore:"instance,omitempty"`
+	// @inject_tag: firestore:"data,omitempty"
+	Data map[string]string `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" firestore:"data,omitempty"`
+	// message is the object containing the configuration of an FCM message
+	// see https://pkg.go.dev/firebase.google.com/go/messaging#Message
+	// this object is already populated from the template and corresponds to
+	// what was sent to the FCM API
+	// @inject_tag: firestore:"message,omitempty"
+	Message *FCMMessage `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty" firestore:"message,omitempty"`
+	// @inject_tag: firestore:"-"
+	Id string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty" firestore:"-"`
+	// ref, instance_ids and tokens are the recipients of the notification. A notification
+	// is recorded for each ref of the recipients, tokens without an instance are recorded
+	// without the ref
+	// @inject_tag: firestore:"ref,omitempty"
+	Ref string `protobuf:"bytes,5,opt,name=ref,proto3" json:"ref,omitempty" firestore:"ref,omitempty"`
+	// @inject_tag: firestore:"instanceIds,omitempty"
+	InstanceIds []string `protobuf:"bytes,6,rep,name=instance_ids,json=instanceIds,proto3" json:"instance_ids,omitempty" firestore:"instanceIds,omitempty"`
+	// @inject_tag: firestore:"tokens,omitempty"
+	Tokens []string `protobuf:"bytes,7,rep,name=tokens,proto3" json:"tokens,omitempty" firestore:"tokens,omitempty"`
+	// @inject_tag: firestore:"topic,omitempty"
+	Topic string `protobuf:"bytes,8,opt,name=topic,proto3" json:"topic,omitempty" firestore:"topic,omitempty"`
+	// @inject_tag: firestore:"condition,omitempty"
+	Condition string `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition,omitempty" firestore:"condition,omitempty"`
+	// @inject_tag: firestore:"templateId,omitempty"
+	TemplateId string `protobuf:"bytes,10,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty" firestore:"templateId,omitempty"`
+	// @inject_tag: firestore:"category,omitempty"
+	Category string `protobuf:"bytes,11,opt,name=category,proto3" json:"category,omitempty" firestore:"category,omitempty"`
+	// @inject_tag: firestore:"state,omitempty"
+	State Notification_State `protobuf:"varint,12,opt,name=state,proto3,enum=fcmcompanion.v1.Notification_State" json:"state,omitempty" firestore:"state,omitempty"`
+	// reason describes why the notification was suppressed or failed
+	// @inject_tag: firestore:"reason,omitempty"
+	Reason string `protobuf:"bytes,13,opt,name=reason,proto3" json:"reason,omitempty" firestore:"reason,omitempty"`
+	// @inject_tag: firestore:"createdAt,omitempty"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" firestore:"createdAt,omitempty"`
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *Notification) GetInstance() *AppInstance {
+	if x != nil {
+		return x.Instance
+	}
+	return nil
+}
+
+func (x *Notification) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Notification) GetMessage() *FCMMessage {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Notification) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Notification) GetRef() string {
+	if x != nil {
+		return x.Ref
+	}
+	return ""
+}
+
+func (x *Notification) GetInstanceIds() []string {
+	if x != nil {
+		return x.InstanceIds
+	}
+	return nil
+}
+
+func (x *Notification) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *Notification) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *Notification) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *Notification) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *Notification) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Notification) GetState() Notification_State {
+	if x != nil {
+		return x.State
+	}
+	return Notification_STATE_UNSPECIFIED
+}
+
+func (x *Notification) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Notification) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// DeadLetter is a Pub/Sub delivered request that can't be processed without
+// a change of the request or the configuration
+type DeadLetter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the hex SHA-256 of the delivery key of the Pub/Sub message, i.e. its idempotency
+	// key or message ID scoped by the tenant, if available, generated otherwise
+	// @inject_tag: firestore:"-"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" firestore:"-"`
+	// method is the name of the RPC the payload was sent to, e.g. Send
+	// @inject_tag: firestore:"method,omitempty"
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty" firestore:"method,omitempty"`
+	// payload is the original JSON body of the request
+	// @inject_tag: firestore:"payload,omitempty"
+	Payload string `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty" firestore:"payload,omitempty"`
+	// reason is the error returned when the request was processed
+	// @inject_tag: firestore:"reason,omitempty"
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty" firestore:"reason,omitempty"`
+	// @inject_tag: firestore:"subscription,omitempty"
+	Subscription string `protobuf:"bytes,5,opt,name=subscription,proto3" json:"subscription,omitempty" firestore:"subscription,omitempty"`
+	// @inject_tag: firestore:"createdAt,omitempty"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty" firestore:"createdAt,omitempty"`
+	// tenant is the tenant attribute of the Pub/Sub message
+	// @inject_tag: firestore:"tenant,omitempty"
+	Tenant string `protobuf:"bytes,7,opt,name=tenant,proto3" json:"tenant,omitempty" firestore:"tenant,omitempty"`
+	// refs and tokens are the recipients of the payload, so the dead letters of
+	// removed users can be found
+	// @inject_tag: firestore:"refs,omitempty"
+	Refs []string `protobuf:"bytes,8,rep,name=refs,proto3" json:"refs,omitempty" firestore:"refs,omitempty"`
+	// @inject_tag: firestore:"tokens,omitempty"
+	Tokens []string `protobuf:"bytes,9,rep,name=tokens,proto3" json:"tokens,omitempty" firestore:"tokens,omitempty"`
+}
+
+func (x *DeadLetter) Reset() {
+	*x = DeadLetter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetter) ProtoMessage() {}
+
+func (x *DeadLetter) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetter.ProtoReflect.Descriptor instead.
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DeadLetter) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetSubscription() string {
+	if x != nil {
+		return x.Subscription
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetTenant() string {
+	if x != nil {
+		return x.Tenant
+	}
+	return ""
+}
+
+func (x *DeadLetter) GetRefs() []string {
+	if x != nil {
+		return x.Refs
+	}
+	return nil
+}
+
+func (x *DeadLetter) GetTokens() []string {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type ListDeadLettersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PageSize  int32  `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListDeadLettersRequest) Reset() {
+	*x = ListDeadLettersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListDeadLettersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeadLettersRequest) ProtoMessage() {}
+
+func (x *ListDeadLettersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeadLettersRequest.ProtoReflect.Descriptor instead.
+func (*ListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListDeadLettersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListDeadLettersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type DeadLetterList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeadLetters   []*DeadLetter `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *DeadLetterList) Reset() {
+	*x = DeadLetterList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeadLetterList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeadLetterList) ProtoMessage() {}
+
+func (x *DeadLetterList) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeadLetterList.ProtoReflect.Descriptor instead.
+func (*DeadLetterList) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeadLetterList) GetDeadLetters() []*DeadLetter {
+	if x != nil {
+		return x.DeadLetters
+	}
+	return nil
+}
+
+func (x *DeadLetterList) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ReplayDeadLetterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ReplayDeadLetterRequest) Reset() {
+	*x = ReplayDeadLetterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayDeadLetterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayDeadLetterRequest) ProtoMessage() {}
+
+func (x *ReplayDeadLetterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayDeadLetterRequest.ProtoReflect.Descriptor instead.
+func (*ReplayDeadLetterRequest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ReplayDeadLetterRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// NotificationConfig is the object of a configuration file parsed from the
+// remote config. It contains all message templates
+type NotificationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore:"messages,omitempty"
+	Messages []*MessageTemplate `protobuf:"bytes,1,rep,name=messages,proto3" json:"messages,omitempty" firestore:"messages,omitempty"`
+	// quiet_hours is the global quiet hours window applied to all messages
+	// @inject_tag: firestore:"quietHours,omitempty"
+	QuietHours *QuietHours `protobuf:"bytes,2,opt,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty" firestore:"quietHours,omitempty"`
+	// category_quiet_hours are the quiet hours of the template categories, taking
+	// precedence over the global quiet hours
+	// @inject_tag: firestore:"categoryQuietHours,omitempty"
+	CategoryQuietHours map[string]*QuietHours `protobuf:"bytes,3,rep,name=category_quiet_hours,json=categoryQuietHours,proto3" json:"category_quiet_hours,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" firestore:"categoryQuietHours,omitempty"`
+	// category_frequency_caps are the frequency caps of the template categories,
+	// counted across all templates of the category
+	// @inject_tag: firestore:"categoryFrequencyCaps,omitempty"
+	CategoryFrequencyCaps map[string]*FrequencyCap `protobuf:"bytes,4,rep,name=category_frequency_caps,json=categoryFrequencyCaps,proto3" json:"category_frequency_caps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" firestore:"categoryFrequencyCaps,omitempty"`
+}
+
+func (x *NotificationConfig) Reset() {
+	*x = NotificationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationConfig) ProtoMessage() {}
+
+func (x *NotificationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationConfig.ProtoReflect.Descriptor instead.
+func (*NotificationConfig) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *NotificationConfig) GetMessages() []*MessageTemplate {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *NotificationConfig) GetQuietHours() *QuietHours {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *NotificationConfig) GetCategoryQuietHours() map[string]*QuietHours {
+	if x != nil {
+		return x.CategoryQuietHours
+	}
+	return nil
+}
+
+func (x *NotificationConfig) GetCategoryFrequencyCaps() map[string]*FrequencyCap {
+	if x != nil {
+		return x.CategoryFrequencyCaps
+	}
+	return nil
+}
+
+// FrequencyCap limits the number of notifications sent to a ref within a period, e.g.
+// 3 notifications per 24h. Notifications are counted against the notification history,
+// recipients without a ref are not capped
+type FrequencyCap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// max is the maximum number of notifications sent within the period
+	// @inject_tag: firestore:"max,omitempty"
+	Max int32 `protobuf:"varint,1,opt,name=max,proto3" json:"max,omitempty" firestore:"max,omitempty"`
+	// period is the sliding window of the cap as a Go duration, e.g. 24h
+	// @inject_tag: firestore:"period,omitempty"
+	Period string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty" firestore:"period,omitempty"`
+	// action defines what happens to notifications over the cap
+	// @inject_tag: firestore:"action,omitempty"
+	Action FrequencyCap_Action `protobuf:"varint,3,opt,name=action,proto3,enum=fcmcompanion.v1.FrequencyCap_Action" json:"action,omitempty" firestore:"action,omitempty"`
+}
+
+func (x *FrequencyCap) Reset() {
+	*x = FrequencyCap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FrequencyCap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FrequencyCap) ProtoMessage() {}
+
+func (x *FrequencyCap) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FrequencyCap.ProtoReflect.Descriptor instead.
+func (*FrequencyCap) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *FrequencyCap) GetMax() int32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *FrequencyCap) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *FrequencyCap) GetAction() FrequencyCap_Action {
+	if x != nil {
+		return x.Action
+	}
+	return FrequencyCap_DROP
+}
+
+// QuietHours is a daily window of the local time during which notifications are not
+// delivered. Quiet hours of the instance take precedence over the ones of the template
+// category, which take precedence over the global ones
+type QuietHours struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// start and end of the window in the HH:MM format. Windows may span midnight,
+	// e.g. 22:00 to 07:00. The end is exclusive
+	// @inject_tag: firestore:"start,omitempty"
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty" firestore:"start,omitempty"`
+	// @inject_tag: firestore:"end,omitempty"
+	End string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty" firestore:"end,omitempty"`
+	// action defines what happens to notifications sent during the window
+	// @inject_tag: firestore:"action,omitempty"
+	Action QuietHours_Action `protobuf:"varint,3,opt,name=action,proto3,enum=fcmcompanion.v1.QuietHours_Action" json:"action,omitempty" firestore:"action,omitempty"`
+	// timezone is used for recipients without a timezone. Defaults to UTC
+	// @inject_tag: firestore:"timezone,omitempty"
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty" firestore:"timezone,omitempty"`
+}
+
+func (x *QuietHours) Reset() {
+	*x = QuietHours{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuietHours) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietHours) ProtoMessage() {}
+
+func (x *QuietHours) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietHours.ProtoReflect.Descriptor instead.
+func (*QuietHours) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *QuietHours) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *QuietHours) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *QuietHours) GetAction() QuietHours_Action {
+	if x != nil {
+		return x.Action
+	}
+	return QuietHours_DEFER
+}
+
+func (x *QuietHours) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+type MessageTemplate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is a unique key under which the template is referenced
+	// this field is other referenced as 'instance_id'
+	// @inject_tag: firestore:"id,omitempty"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty" firestore:"id,omitempty"`
+	// message is the legacy configuration of the FCM message, keyed by the fields of
+	// the FCMMessage. Templates without the fcm_message are migrated from it when the
+	// configuration is loaded. Deprecated: use the fcm_message
+	// @inject_tag: firestore:"message,omitempty"
+	//
+	// Deprecated: Do not use.
+	Message map[string]*any1.Any `protobuf:"bytes,2,rep,name=message,proto3" json:"message,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" firestore:"message,omitempty"`
+	// fcm_message is the object containing the configuration of an FCM message
+	// see https://pkg.go.dev/firebase.google.com/go/messaging#Message
+	// this object will be parsed from the configuration file directly into the
+	// FCM message specification. String values are rendered as Go templates
+	// using the templateData of the sent message, e.g. "Hello {{.name}}"
+	// @inject_tag: firestore:"fcmMessage,omitempty"
+	FcmMessage *FCMMessage `protobuf:"bytes,7,opt,name=fcm_message,json=fcmMessage,proto3" json:"fcm_message,omitempty" firestore:"fcmMessage,omitempty"`
+	// category groups templates for the category_quiet_hours, e.g. marketing
+	// @inject_tag: firestore:"category,omitempty"
+	Category string `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty" firestore:"category,omitempty"`
+	// frequency_cap limits the notifications of the template, taking precedence
+	// over the cap of the category
+	// @inject_tag: firestore:"frequencyCap,omitempty"
+	FrequencyCap *FrequencyCap `protobuf:"bytes,4,opt,name=frequency_cap,json=frequencyCap,proto3" json:"frequency_cap,omitempty" firestore:"frequencyCap,omitempty"`
+	// collapse_group is the logical group of the notifications replacing each other, e.g.
+	// "order-{{.orderId}}". It's rendered as the message and sets the Android collapse_key
+	// and tag, the APNs apns-collapse-id, and the webpush tag and Topic, overriding
+	// the values of the message. Groups longer than 64 bytes are hashed
+	// @inject_tag: firestore:"collapseGroup,omitempty"
+	CollapseGroup string `protobuf:"bytes,5,opt,name=collapse_group,json=collapseGroup,proto3" json:"collapse_group,omitempty" firestore:"collapseGroup,omitempty"`
+	// digest aggregates the messages of the template sent to the same ref within
+	// the window into a single digest notification. Critical messages are not digested
+	// @inject_tag: firestore:"digest,omitempty"
+	Digest *Digest `protobuf:"bytes,6,opt,name=digest,proto3" json:"digest,omitempty" firestore:"digest,omitempty"`
+}
+
+func (x *MessageTemplate) Reset() {
+	*x = MessageTemplate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageTemplate) ProtoMessage() {}
+
+func (x *MessageTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageTemplate.ProtoReflect.Descriptor instead.
+func (*MessageTemplate) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MessageTemplate) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Deprecated: Do not use.
+func (x *MessageTemplate) GetMessage() map[string]*any1.Any {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *MessageTemplate) GetFcmMessage() *FCMMessage {
+	if x != nil {
+		return x.FcmMessage
+	}
+	return nil
+}
+
+func (x *MessageTemplate) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *MessageTemplate) GetFrequencyCap() *FrequencyCap {
+	if x != nil {
+		return x.FrequencyCap
+	}
+	return nil
+}
+
+func (x *MessageTemplate) GetCollapseGroup() string {
+	if x != nil {
+		return x.CollapseGroup
+	}
+	return ""
+}
+
+func (x *MessageTemplate) GetDigest() *Digest {
+	if x != nil {
+		return x.Digest
+	}
+	return nil
+}
+
+// Digest aggregates the messages sent to a ref within a window. The window starts
+// with the first message and a single message is sent unchanged. Otherwise the digest
+// template is rendered with the template_data of the first message and:
+//
+//	count: the number of the messages
+//	items: the item rendered for each message, joined by the separator
+//	more: the number of the messages not listed in the items
+type Digest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// window is the Go duration of the window, e.g. 15m
+	// @inject_tag: firestore:"window,omitempty"
+	Window string `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty" firestore:"window,omitempty"`
+	// template_id is the template of the digest notification. The template can't be
+	// digested itself
+	// @inject_tag: firestore:"templateId,omitempty"
+	TemplateId string `protobuf:"bytes,2,opt,name=template_id,json=templateId,proto3" json:"template_id,omitempty" firestore:"templateId,omitempty"`
+	// item is rendered for each message with its template_data, e.g. "{{.user}} liked your post"
+	// @inject_tag: firestore:"item,omitempty"
+	Item string `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty" firestore:"item,omitempty"`
+	// separator joins the items. Defaults to a new line
+	// @inject_tag: firestore:"separator,omitempty"
+	Separator string `protobuf:"bytes,4,opt,name=separator,proto3" json:"separator,omitempty" firestore:"separator,omitempty"`
+	// max_items is the maximum number of the listed items. Defaults to 10
+	// @inject_tag: firestore:"maxItems,omitempty"
+	MaxItems int32 `protobuf:"varint,5,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty" firestore:"maxItems,omitempty"`
+}
+
+func (x *Digest) Reset() {
+	*x = Digest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Digest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Digest) ProtoMessage() {}
+
+func (x *Digest) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Digest.ProtoReflect.Descriptor instead.
+func (*Digest) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *Digest) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+func (x *Digest) GetTemplateId() string {
+	if x != nil {
+		return x.TemplateId
+	}
+	return ""
+}
+
+func (x *Digest) GetItem() string {
+	if x != nil {
+		return x.Item
+	}
+	return ""
+}
+
+func (x *Digest) GetSeparator() string {
+	if x != nil {
+		return x.Separator
+	}
+	return ""
+}
+
+func (x *Digest) GetMaxItems() int32 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+type FCMMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore:"data,omitempty"
+	Data map[string]string `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" firestore:"data,omitempty"`
+	// @inject_tag: firestore:"notification,omitempty"
+	Notification *FCMNotification `protobuf:"bytes,2,opt,name=notification,proto3" json:"notification,omitempty" firestore:"notification,omitempty"`
+	// @inject_tag: firestore:"android,omitempty"
+	Android *FCMAndroid `protobuf:"bytes,3,opt,name=android,proto3" json:"android,omitempty" firestore:"android,omitempty"`
+	// @inject_tag: firestore:"webpush,omitempty"
+	Webpush *FCMWebpush `protobuf:"bytes,4,opt,name=webpush,proto3" json:"webpush,omitempty" firestore:"webpush,omitempty"`
+	// @inject_tag: firestore:"apns,omitempty"
+	Apns *FCMAPNSConfig `protobuf:"bytes,5,opt,name=apns,proto3" json:"apns,omitempty" firestore:"apns,omitempty"`
+	// @inject_tag: firestore:"fcm_options,omitempty"
+	FcmOptions *FCMOptions `protobuf:"bytes,6,opt,name=fcm_options,json=fcmOptions,proto3" json:"fcm_options,omitempty" firestore:"fcm_options,omitempty"`
+	// @inject_tag: firestore:"token,omitempty"
+	Token string `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty" firestore:"token,omitempty"`
+	// @inject_tag: firestore:"topic,omitempty"
+	Topic string `protobuf:"bytes,8,opt,name=topic,proto3" json:"topic,omitempty" firestore:"topic,omitempty"`
+	// @inject_tag: firestore:"condition,omitempty"
+	Condition string `protobuf:"bytes,9,opt,name=condition,proto3" json:"condition,omitempty" firestore:"condition,omitempty"`
+}
+
+func (x *FCMMessage) Reset() {
+	*x = FCMMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FCMMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FCMMessage) ProtoMessage() {}
+
+func (x *FCMMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FCMMessage.ProtoReflect.Descriptor instead.
+func (*FCMMessage) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *FCMMessage) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FCMMessage) GetNotification() *FCMNotification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *FCMMessage) GetAndroid() *FCMAndroid {
+	if x != nil {
+		return x.Android
+	}
+	return nil
+}
+
+func (x *FCMMessage) GetWebpush() *FCMWebpush {
+	if x != nil {
+		return x.Webpush
+	}
+	return nil
+}
+
+func (x *FCMMessage) GetApns() *FCMAPNSConfig {
+	if x != nil {
+		return x.Apns
+	}
+	return nil
+}
+
+func (x *FCMMessage) GetFcmOptions() *FCMOptions {
+	if x != nil {
+		return x.FcmOptions
+	}
+	return nil
+}
+
+func (x *FCMMessage) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *FCMMessage) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *FCMMessage) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+type FCMNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore:"title,omitempty"
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" firestore:"title,omitempty"`
+	// @inject_tag: firestore:"body,omitempty"
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty" firestore:"body,omitempty"`
+	// @inject_tag: firestore:"imageURL,omitempty"
+	ImageUrl string `protobuf:"bytes,3,opt,name=image_url,json=imageUrl,proto3" json:"image_url,omitempty" firestore:"imageURL,omitempty"`
+}
+
+func (x *FCMNotification) Reset() {
+	*x = FCMNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FCMNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FCMNotification) ProtoMessage() {}
+
+func (x *FCMNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FCMNotification.ProtoReflect.Descriptor instead.
+func (*FCMNotification) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *FCMNotification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FCMNotification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *FCMNotification) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+// see https://pkg.go.dev/firebase.google.com/go/messaging#AndroidConfig
+type FCMAndroid struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore:"collapse_key,omitempty"
+	CollapseKey string `protobuf:"bytes,1,opt,name=collapse_key,json=collapseKey,proto3" json:"collapse_key,omitempty" firestore:"collapse_key,omitempty"`
+	// @inject_tag: firestore:"priority,omitempty"
+	Priority string `protobuf:"bytes,2,opt,name=priority,proto3" json:"priority,omitempty" firestore:"priority,omitempty"`
+	// @inject_tag: firestore:"ttl,omitempty" json:"-"
+	Ttl *timestamp.Timestamp `protobuf:"bytes,3,opt,name=ttl,proto3" json:"-" firestore:"ttl,omitempty"`
+	// @inject_tag: firestore:"restricted_package_name,omitempty"
+	RestrictedPackageName string `protobuf:"bytes,4,opt,name=restricted_package_name,json=restrictedPackageName,proto3" json:"restricted_package_name,omitempty" firestore:"restricted_package_name,omitempty"`
+	// @inject_tag: firestore:"data,omitempty"
+	Data map[string]string `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3" firestore:"data,omitempty"`
+	// @inject_tag: firestore:"notification,omitempty"
+	Notification *FCMAndroidNotification `protobuf:"bytes,6,opt,name=notification,proto3" json:"notification,omitempty" firestore:"notification,omitempty"`
+	// @inject_tag: firestore:"fcm_options,omitempty" json:"fcm_options,omitempty"
+	FcmOptions *FCMAndroidOptions `protobuf:"bytes,7,opt,name=fcm_options,json=fcmOptions,proto3" json:"fcm_options,omitempty" firestore:"fcm_options,omitempty"`
+}
+
+func (x *FCMAndroid) Reset() {
+	*x = FCMAndroid{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FCMAndroid) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FCMAndroid) ProtoMessage() {}
+
+func (x *FCMAndroid) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FCMAndroid.ProtoReflect.Descriptor instead.
+func (*FCMAndroid) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *FCMAndroid) GetCollapseKey() string {
+	if x != nil {
+		return x.CollapseKey
+	}
+	return ""
+}
+
+func (x *FCMAndroid) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *FCMAndroid) GetTtl() *timestamp.Timestamp {
+	if x != nil {
+		return x.Ttl
+	}
+	return nil
+}
+
+func (x *FCMAndroid) GetRestrictedPackageName() string {
+	if x != nil {
+		return x.RestrictedPackageName
+	}
+	return ""
+}
+
+func (x *FCMAndroid) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FCMAndroid) GetNotification() *FCMAndroidNotification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *FCMAndroid) GetFcmOptions() *FCMAndroidOptions {
+	if x != nil {
+		return x.FcmOptions
+	}
+	return nil
+}
+
+// see https://pkg.go.dev/firebase.google.com/go/messaging#AndroidNotification
+type FCMAndroidNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore"title,omitempty"
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// @inject_tag: firestore"body,omitempty"
+	Body string `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	// @inject_tag: firestore"icon,omitempty"
+	Icon string `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+	// @inject_tag: firestore"color,omitempty"
+	Color string `protobuf:"bytes,4,opt,name=color,proto3" json:"color,omitempty"`
+	// @inject_tag: firestore"sound,omitempty"
+	Sound string `protobuf:"bytes,5,opt,name=sound,proto3" json:"sound,omitempty"`
+	// @inject_tag: firestore"tag,omitempty"
+	Tag string `protobuf:"bytes,6,opt,name=tag,proto3" json:"tag,omitempty"`
+	// @inject_tag: firestore"click_action,omitempty"
+	ClickAction string `protobuf:"bytes,7,opt,name=click_action,json=clickAction,proto3" json:"click_action,omitempty"`
+	// @inject_tag: firestore"body_loc_key,omitempty"
+	BodyLocKey string `protobuf:"bytes,8,opt,name=body_loc_key,json=bodyLocKey,proto3" json:"body_loc_key,omitempty"`
+	// @inject_tag: firestore"body_loc_args,omitempty"
+	BodyLocArgs []string `protobuf:"bytes,9,rep,name=body_loc_args,json=bodyLocArgs,proto3" json:"body_loc_args,omitempty"`
+	// @inject_tag: firestore"title_loc_key,omitempty"
+	TitleLocKey string `protobuf:"bytes,10,opt,name=title_loc_key,json=titleLocKey,proto3" json:"title_loc_key,omitempty"`
+	// @inject_tag: firestore"title_loc_args,omitempty"
+	TitleLocArgs []string `protobuf:"bytes,11,rep,name=title_loc_args,json=titleLocArgs,proto3" json:"title_loc_args,omitempty"`
+	// @inject_tag: firestore"channel_id,omitempty"
+	ChannelId string `protobuf:"bytes,12,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// @inject_tag: firestore:"image,omitempty" json:"image,omitempty"
+	ImageUrl string `protobuf:"bytes,13,opt,name=image_url,json=imageUrl,proto3" json:"image,omitempty" firestore:"image,omitempty"`
+}
+
+func (x *FCMAndroidNotification) Reset() {
+	*x = FCMAndroidNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FCMAndroidNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FCMAndroidNotification) ProtoMessage() {}
+
+func (x *FCMAndroidNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FCMAndroidNotification.ProtoReflect.Descriptor instead.
+func (*FCMAndroidNotification) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *FCMAndroidNotification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FCMAndroidNotification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *FCMAndroidNotification) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *FCMAndroidNotification) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *FCMAndroidNotification) GetSound() string {
+	if x != nil {
+		return x.Sound
+	}
+	return ""
+}
+
+func (x *FCMAndroidNotification) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *FCMAndroidNotification) GetClickAction() string {
+	if x != nil {
+		return x.ClickAction
+	}
+	return ""
+}
+
+func (x *FCMAndroidNotification) GetBodyLocKey() string {
+	if x != nil {
+		return x.BodyLocKey
+	}
+	return ""
+}
+
+func (x *FCMAndroidNotification) GetBodyLocArgs() []string {
+	if x != nil {
+		return x.BodyLocArgs
+	}
+	return nil
+}
+
+func (x *FCMAndroidNotification) GetTitleLocKey() string {
+	if x != nil {
+		return x.TitleLocKey
+	}
+	return ""
+}
+
+func (x *FCMAndroidNotification) GetTitleLocArgs() []string {
+	if x != nil {
+		return x.TitleLocArgs
+	}
+	return nil
+}
+
+func (x *FCMAndroidNotification) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *FCMAndroidNotification) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+// see https://pkg.go.dev/firebase.google.com/go/messaging#AndroidFCMOptions
+type FCMAndroidOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore:"analyticsLabel,omitempty"
+	AnalyticsLabel string `protobuf:"bytes,1,opt,name=analytics_label,json=analyticsLabel,proto3" json:"analytics_label,omitempty" firestore:"analyticsLabel,omitempty"`
+}
+
+func (x *FCMAndroidOptions) Reset() {
+	*x = FCMAndroidOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FCMAndroidOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FCMAndroidOptions) ProtoMessage() {}
+
+func (x *FCMAndroidOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FCMAndroidOptions.ProtoReflect.Descriptor instead.
+func (*FCMAndroidOptions) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *FCMAndroidOptions) GetAnalyticsLabel() string {
+	if x != nil {
+		return x.AnalyticsLabel
+	}
+	return ""
+}
+
+// see https://pkg.go.dev/firebase.google.com/go/messaging#WebpushConfig
+type FCMWebpush struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore"headers,omitempty"
+	Headers map[string]string `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// @inject_tag: firestore"data,omitempty"
+	Data map[string]string `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// @inject_tag: firestore"notification,omitempty"
+	Notification *FCMWebpushNotification `protobuf:"bytes,3,opt,name=notification,proto3" json:"notification,omitempty"`
+	// @inject_tag: firestore"fcm_options,omitempty" json:"fcm_options,omitempty"
+	FcmOptions *FCMWebpushOptions `protobuf:"bytes,4,opt,name=fcm_options,json=fcmOptions,proto3" json:"fcm_options,omitempty"`
+}
+
+func (x *FCMWebpush) Reset() {
+	*x = FCMWebpush{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FCMWebpush) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FCMWebpush) ProtoMessage() {}
+
+func (x *FCMWebpush) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FCMWebpush.ProtoReflect.Descriptor instead.
+func (*FCMWebpush) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *FCMWebpush) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *FCMWebpush) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FCMWebpush) GetNotification() *FCMWebpushNotification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *FCMWebpush) GetFcmOptions() *FCMWebpushOptions {
+	if x != nil {
+		return x.FcmOptions
+	}
+	return nil
+}
+
+// see https://pkg.go.dev/firebase.google.com/go/messaging#WebpushNotification
+type FCMWebpushNotification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actions            []*FCMWebpushNotificationAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	Title              string                          `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Body               string                          `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	Icon               string                          `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Badge              string                          `protobuf:"bytes,5,opt,name=badge,proto3" json:"badge,omitempty"`
+	Direction          string                          `protobuf:"bytes,6,opt,name=direction,proto3" json:"direction,omitempty"`
+	Data               *any1.Any                       `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	Image              string                          `protobuf:"bytes,8,opt,name=image,proto3" json:"image,omitempty"`
+	Language           string                          `protobuf:"bytes,9,opt,name=language,proto3" json:"language,omitempty"`
+	Renotify           bool                            `protobuf:"varint,10,opt,name=renotify,proto3" json:"renotify,omitempty"`
+	RequireInteraction bool                            `protobuf:"varint,11,opt,name=require_interaction,json=requireInteraction,proto3" json:"require_interaction,omitempty"`
+	Silent             bool                            `protobuf:"varint,12,opt,name=silent,proto3" json:"silent,omitempty"`
+	Tag                string                          `protobuf:"bytes,13,opt,name=tag,proto3" json:"tag,omitempty"`
+	// this should be *int64
+	TimestampMillis int64                `protobuf:"varint,14,opt,name=timestamp_millis,json=timestampMillis,proto3" json:"timestamp_millis,omitempty"`
+	Vibrate         []int64              `protobuf:"varint,15,rep,packed,name=vibrate,proto3" json:"vibrate,omitempty"`
+	CustomData      map[string]*any1.Any `protobuf:"bytes,16,rep,name=custom_data,json=customData,proto3" json:"custom_data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *FCMWebpushNotification) Reset() {
+	*x = FCMWebpushNotification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FCMWebpushNotification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FCMWebpushNotification) ProtoMessage() {}
+
+func (x *FCMWebpushNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FCMWebpushNotification.ProtoReflect.Descriptor instead.
+func (*FCMWebpushNotification) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *FCMWebpushNotification) GetActions() []*FCMWebpushNotificationAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *FCMWebpushNotification) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FCMWebpushNotification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *FCMWebpushNotification) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+func (x *FCMWebpushNotification) GetBadge() string {
+	if x != nil {
+		return x.Badge
+	}
+	return ""
+}
+
+func (x *FCMWebpushNotification) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *FCMWebpushNotification) GetData() *any1.Any {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FCMWebpushNotification) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *FCMWebpushNotification) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *FCMWebpushNotification) GetRenotify() bool {
+	if x != nil {
+		return x.Renotify
+	}
+	return false
+}
+
+func (x *FCMWebpushNotification) GetRequireInteraction() bool {
+	if x != nil {
+		return x.RequireInteraction
+	}
+	return false
+}
+
+func (x *FCMWebpushNotification) GetSilent() bool {
+	if x != nil {
+		return x.Silent
+	}
+	return false
+}
+
+func (x *FCMWebpushNotification) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *FCMWebpushNotification) GetTimestampMillis() int64 {
+	if x != nil {
+		return x.TimestampMillis
+	}
+	return 0
+}
+
+func (x *FCMWebpushNotification) GetVibrate() []int64 {
+	if x != nil {
+		return x.Vibrate
+	}
+	return nil
+}
+
+func (x *FCMWebpushNotification) GetCustomData() map[string]*any1.Any {
+	if x != nil {
+		return x.CustomData
+	}
+	return nil
+}
+
+// see https://pkg.go.dev/firebase.google.com/go/messaging#WebpushNotificationAction
+type FCMWebpushNotificationAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore"action,omitempty"
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// @inject_tag: firestore"title,omitempty"
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// @inject_tag: firestore"icon,omitempty"
+	Icon string `protobuf:"bytes,3,opt,name=icon,proto3" json:"icon,omitempty"`
+}
+
+func (x *FCMWebpushNotificationAction) Reset() {
+	*x = FCMWebpushNotificationAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FCMWebpushNotificationAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FCMWebpushNotificationAction) ProtoMessage() {}
+
+func (x *FCMWebpushNotificationAction) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FCMWebpushNotificationAction.ProtoReflect.Descriptor instead.
+func (*FCMWebpushNotificationAction) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *FCMWebpushNotificationAction) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *FCMWebpushNotificationAction) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *FCMWebpushNotificationAction) GetIcon() string {
+	if x != nil {
+		return x.Icon
+	}
+	return ""
+}
+
+// see https://pkg.go.dev/firebase.google.com/go/messaging#WebpushFcmOptions
+type FCMWebpushOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore"link,omitempty"
+	Link string `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (x *FCMWebpushOptions) Reset() {
+	*x = FCMWebpushOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FCMWebpushOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FCMWebpushOptions) ProtoMessage() {}
+
+func (x *FCMWebpushOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FCMWebpushOptions.ProtoReflect.Descriptor instead.
+func (*FCMWebpushOptions) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *FCMWebpushOptions) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+// see https://pkg.go.dev/firebase.google.com/go/messaging#APNSConfig
+type FCMAPNSConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FCMAPNSConfig) Reset() {
+	*x = FCMAPNSConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FCMAPNSConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FCMAPNSConfig) ProtoMessage() {}
+
+func (x *FCMAPNSConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FCMAPNSConfig.ProtoReflect.Descriptor instead.
+func (*FCMAPNSConfig) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{56}
+}
+
+type FCMOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @inject_tag: firestore:"analytics_label,omitempty"
+	AnalyticsLabel string `protobuf:"bytes,1,opt,name=analytics_label,json=analyticsLabel,proto3" json:"analytics_label,omitempty" firestore:"analytics_label,omitempty"`
+}
+
+func (x *FCMOptions) Reset() {
+	*x = FCMOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_notification_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FCMOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FCMOptions) ProtoMessage() {}
+
+func (x *FCMOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_notification_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FCMOptions.ProtoReflect.Descriptor instead.
+func (*FCMOptions) Descriptor() ([]byte, []int) {
+	return file_v1_notification_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *FCMOptions) GetAnalyticsLabel() string {
+	if x != nil {
+		return x.AnalyticsLabel
+	}
+	return ""
+}
+
+var File_v1_notification_proto protoreflect.FileDescriptor
+
+var file_v1_notification_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8f, 0x06, 0x0a, 0x0b, 0x41, 0x70, 0x70,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x40, 0x0a, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x63, 0x6d,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x0c,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x66,
+	0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6f, 0x73, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6f, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
+	0x64, 0x6b, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x64, 0x6b, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x44, 0x0a, 0x10, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x71, 0x75, 0x69, 0x65, 0x74, 0x5f, 0x68, 0x6f,
+	0x75, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x63, 0x6d, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x69, 0x65,
+	0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x0a, 0x71, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75,
+	0x72, 0x73, 0x12, 0x3b, 0x0a, 0x0b, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73,
+	0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d,
+	0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73, 0x6b, 0x1a,
+	0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x12, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49,
+	0x64, 0x22, 0x38, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xea, 0x01, 0x0a, 0x13,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x08, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x3f, 0x0a,
+	0x03, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x66, 0x63, 0x6d,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x73, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x1a, 0x36, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28,
+	0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x08, 0x52, 0x0a, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0xe2, 0x04, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x72, 0x65, 0x66, 0x12, 0x49, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x47,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x31, 0x2e,
+	0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x42, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x73, 0x65, 0x65, 0x6e, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x66, 0x74, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x10, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x3f, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f,
+	0x72, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x1a,
+	0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x41, 0x0a, 0x0b, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x4f, 0x4b,
+	0x45, 0x4e, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x4f, 0x4b, 0x45,
+	0x4e, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54,
+	0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x41, 0x42, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x22, 0x6f, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x72,
+	0x65, 0x66, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2e,
+	0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0xf3,
+	0x01, 0x0a, 0x17, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x0d, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x0b, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x78,
+	0x41, 0x67, 0x65, 0x12, 0x4d, 0x0a, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02,
+	0x32, 0x00, 0x52, 0x0e, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x61, 0x78, 0x41,
+	0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x64,
+	0x72, 0x79, 0x5f, 0x72, 0x75, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72,
+	0x79, 0x52, 0x75, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x2b,
+	0x0a, 0x11, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x33, 0x0a, 0x18, 0x44,
+	0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x72, 0x79, 0x5f, 0x72,
+	0x75, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e,
+	0x22, 0x85, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73,
+	0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x78, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x06,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66,
+	0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x22, 0x58, 0x0a, 0x0b, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x72, 0x6f, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x39, 0x0a, 0x16,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x75, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3a, 0x0a, 0x09, 0x69, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4b,
+	0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x79, 0x0a, 0x0e, 0x53,
+	0x65, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a,
+	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01,
+	0x02, 0x08, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x27, 0x0a,
+	0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x22, 0x5d, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45,
+	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa7, 0x04, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x28, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x0c, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x74,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x63, 0x6d, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x65, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12,
+	0x33, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x4b, 0x65, 0x79, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xd8, 0x04, 0x0a, 0x10, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x57,
+	0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x12, 0x27, 0x0a, 0x0f, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64,
+	0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c,
+	0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x8c, 0x01, 0x0a, 0x09, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x04, 0x68, 0x6f, 0x75, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x17, 0x28,
+	0x00, 0x52, 0x04, 0x68, 0x6f, 0x75, 0x72, 0x12, 0x21, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x3b,
+	0x28, 0x00, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29,
+	0x0a, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x3b, 0x0a, 0x14, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x08, 0x61, 0x75,
+	0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x22, 0x45, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e,
+	0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x69, 0x6e, 0x73, 0x74,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0xb8, 0x01,
+	0x0a, 0x07, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x51, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6b, 0x0a, 0x0b, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66,
+	0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2f, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x96, 0x02, 0x0a, 0x0b, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x03, 0x72, 0x65, 0x66,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x03, 0x72, 0x65, 0x66, 0x12, 0x4c, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x63, 0x0a,
+	0x0f, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x3a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x22, 0xa2, 0x02, 0x0a, 0x13, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x66, 0x63,
+	0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x73, 0x65, 0x6e, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x07, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x76, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x66, 0x63, 0x6d,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x26,
+	0xfa, 0x42, 0x23, 0x9a, 0x01, 0x20, 0x22, 0x17, 0x72, 0x15, 0x32, 0x13, 0x5e, 0x28, 0x61, 0x6e,
+	0x64, 0x72, 0x6f, 0x69, 0x64, 0x7c, 0x69, 0x6f, 0x73, 0x7c, 0x77, 0x65, 0x62, 0x29, 0x24, 0x2a,
+	0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x1a, 0x55, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x32, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x19, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x85, 0x04, 0x0a, 0x15,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x33, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x12, 0x42, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x66, 0x63,
+	0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x64, 0x75, 0x65, 0x5f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x05, 0x64, 0x75, 0x65, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x66,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x5c, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15,
+	0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12,
+	0x08, 0x0a, 0x04, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49,
+	0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45,
+	0x44, 0x10, 0x05, 0x22, 0xa0, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x66, 0x63,
+	0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x19, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x4c, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66, 0x63,
+	0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x31, 0x0a, 0x16, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0xdb, 0x01,
+	0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x63, 0x6d,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70,
+	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02,
+	0x08, 0x01, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x66, 0x63, 0x6d, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7f, 0x0a, 0x10, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x43, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x97, 0x05, 0x0a,
+	0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a,
+	0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x08, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x72,
+	0x65, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69,
+	0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x44, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x55,
+	0x50, 0x50, 0x52, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x22, 0x89, 0x02, 0x0a, 0x0a, 0x44, 0x65, 0x61, 0x64, 0x4c,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x66, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x66, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x22, 0x54, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x78, 0x0a, 0x0e, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x64, 0x65,
+	0x61, 0x64, 0x5f, 0x6c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x0b, 0x64,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x32, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x61, 0x64,
+	0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0xc4, 0x04, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3c, 0x0a,
+	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x71,
+	0x75, 0x69, 0x65, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x0a, 0x71,
+	0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x6d, 0x0a, 0x14, 0x63, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x5f, 0x71, 0x75, 0x69, 0x65, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x61, 0x74,
+	0x65, 0x67, 0x6f, 0x72, 0x79, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x51, 0x75,
+	0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x76, 0x0a, 0x17, 0x63, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x79, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x63,
+	0x61, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x66, 0x63, 0x6d, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x43, 0x61, 0x70, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x63, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x70, 0x73,
+	0x1a, 0x62, 0x0a, 0x17, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x51, 0x75, 0x69, 0x65,
+	0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66,
+	0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x67, 0x0a, 0x1a, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x70, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x43,
+	0x61, 0x70, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb1, 0x01,
+	0x0a, 0x0c, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x70, 0x12, 0x19,
+	0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x1a, 0x02, 0x20, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x12, 0x1f, 0x0a, 0x06, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x02, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x46, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x66, 0x63, 0x6d,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x70, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x1d, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x08, 0x0a, 0x04,
+	0x44, 0x52, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x46, 0x45, 0x52, 0x10,
+	0x01, 0x22, 0x87, 0x02, 0x0a, 0x0a, 0x51, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x12, 0x3c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x26, 0xfa, 0x42, 0x23, 0x72, 0x21, 0x32, 0x1f, 0x5e, 0x28, 0x5b, 0x30, 0x31, 0x5d, 0x5b, 0x30,
+	0x2d, 0x39, 0x5d, 0x7c, 0x32, 0x5b, 0x30, 0x2d, 0x33, 0x5d, 0x29, 0x3a, 0x5b, 0x30, 0x2d, 0x35,
+	0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x24, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x38,
+	0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x26, 0xfa, 0x42, 0x23,
+	0x72, 0x21, 0x32, 0x1f, 0x5e, 0x28, 0x5b, 0x30, 0x31, 0x5d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7c,
+	0x32, 0x5b, 0x30, 0x2d, 0x33, 0x5d, 0x29, 0x3a, 0x5b, 0x30, 0x2d, 0x35, 0x5d, 0x5b, 0x30, 0x2d,
+	0x39, 0x5d, 0x24, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x44, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x69, 0x65, 0x74,
+	0x48, 0x6f, 0x75, 0x72, 0x73, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0x1f, 0x0a, 0x06, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x46, 0x45, 0x52, 0x10, 0x00, 0x12,
+	0x0a, 0x0a, 0x06, 0x53, 0x49, 0x4c, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x22, 0xb6, 0x03, 0x0a, 0x0f,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x4b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x02, 0x18, 0x01, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x0b,
+	0x66, 0x63, 0x6d, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0a,
+	0x66, 0x63, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x42, 0x0a, 0x0d, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x79, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x70, 0x52, 0x0c, 0x66, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x61, 0x70, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x2f, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x1a, 0x50, 0x0a, 0x0c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xad, 0x01, 0x0a, 0x06, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x02, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x12, 0x28, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x74,
+	0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x09, 0xfa, 0x42, 0x06, 0x1a, 0x04, 0x18, 0x64, 0x28, 0x00, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x22, 0xf0, 0x03, 0x0a, 0x0a, 0x46, 0x43, 0x4d, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x44,
+	0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x41, 0x6e, 0x64, 0x72, 0x6f,
+	0x69, 0x64, 0x52, 0x07, 0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x77,
+	0x65, 0x62, 0x70, 0x75, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66,
+	0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x43, 0x4d, 0x57, 0x65, 0x62, 0x70, 0x75, 0x73, 0x68, 0x52, 0x07, 0x77, 0x65, 0x62, 0x70, 0x75,
+	0x73, 0x68, 0x12, 0x32, 0x0a, 0x04, 0x61, 0x70, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x41, 0x50, 0x4e, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x04, 0x61, 0x70, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x66, 0x63, 0x6d, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x63,
+	0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43,
+	0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x66, 0x63, 0x6d, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x70, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x37,
+	0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x58, 0x0a, 0x0f, 0x46, 0x43, 0x4d, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72,
+	0x6c, 0x22, 0xb7, 0x03, 0x0a, 0x0a, 0x46, 0x43, 0x4d, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65,
+	0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x2c, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x36, 0x0a,
+	0x17, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x4b, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x41, 0x6e, 0x64, 0x72,
+	0x6f, 0x69, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a,
+	0x0b, 0x66, 0x63, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x66, 0x63, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x83, 0x03, 0x0a, 0x16,
+	0x46, 0x43, 0x4d, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x69, 0x63, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x6f,
+	0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x6f, 0x75, 0x6e, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74,
+	0x61, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x41,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x6c, 0x6f,
+	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6f, 0x64,
+	0x79, 0x4c, 0x6f, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6f, 0x64, 0x79, 0x5f,
+	0x6c, 0x6f, 0x63, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x62, 0x6f, 0x64, 0x79, 0x4c, 0x6f, 0x63, 0x41, 0x72, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x4b, 0x65, 0x79, 0x12,
+	0x24, 0x0a, 0x0e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x61, 0x72, 0x67,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x4c, 0x6f,
+	0x63, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72,
+	0x6c, 0x22, 0x3c, 0x0a, 0x11, 0x46, 0x43, 0x4d, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74,
+	0x69, 0x63, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x22,
+	0x92, 0x03, 0x0a, 0x0a, 0x46, 0x43, 0x4d, 0x57, 0x65, 0x62, 0x70, 0x75, 0x73, 0x68, 0x12, 0x42,
+	0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x43, 0x4d, 0x57, 0x65, 0x62, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x57, 0x65, 0x62, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x44, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x4b, 0x0a,
+	0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x57, 0x65, 0x62, 0x70, 0x75, 0x73, 0x68,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0b, 0x66, 0x63,
+	0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x46, 0x43, 0x4d, 0x57, 0x65, 0x62, 0x70, 0x75, 0x73, 0x68, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x66, 0x63, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x44,
+	0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0x9a, 0x05, 0x0a, 0x16, 0x46, 0x43, 0x4d, 0x57, 0x65, 0x62, 0x70,
+	0x75, 0x73, 0x68, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x47, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2d, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x57, 0x65, 0x62, 0x70, 0x75, 0x73, 0x68, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x62, 0x61, 0x64, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x12, 0x2f, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x6c, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x69, 0x6c, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74,
+	0x61, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x29, 0x0a,
+	0x10, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x62, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x76, 0x69, 0x62, 0x72, 0x61,
+	0x74, 0x65, 0x12, 0x58, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x57, 0x65, 0x62,
+	0x70, 0x75, 0x73, 0x68, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x53, 0x0a, 0x0f,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x60, 0x0a, 0x1c, 0x46, 0x43, 0x4d, 0x57, 0x65, 0x62, 0x70, 0x75, 0x73, 0x68, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69,
+	0x63, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x11, 0x46, 0x43, 0x4d, 0x57, 0x65, 0x62, 0x70, 0x75, 0x73,
+	0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x0f, 0x0a, 0x0d,
+	0x46, 0x43, 0x4d, 0x41, 0x50, 0x4e, 0x53, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x35, 0x0a,
+	0x0a, 0x46, 0x43, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x2a, 0x43, 0x0a, 0x08, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x12, 0x18, 0x0a, 0x14, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x41, 0x4e,
+	0x44, 0x52, 0x4f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x49, 0x4f, 0x53, 0x10, 0x02,
+	0x12, 0x07, 0x0a, 0x03, 0x57, 0x45, 0x42, 0x10, 0x03, 0x2a, 0x3b, 0x0a, 0x07, 0x43, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x4e, 0x54, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a,
+	0x06, 0x4f, 0x50, 0x54, 0x5f, 0x49, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4f, 0x50, 0x54,
+	0x5f, 0x4f, 0x55, 0x54, 0x10, 0x02, 0x32, 0xc4, 0x12, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x45,
+	0x0a, 0x0b, 0x50, 0x75, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x2e,
+	0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x24, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x87, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x64, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x29, 0x2e,
+	0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x64, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x3a, 0x01, 0x2a, 0x22, 0x12, 0x2f,
+	0x64, 0x65, 0x64, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x12, 0x52, 0x0a, 0x0f, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x1a, 0x1d, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x22, 0x00, 0x28, 0x01, 0x12, 0x5c, 0x0a, 0x0f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x52, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x12, 0x23, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x27, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x22, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12,
+	0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x26, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x63, 0x6d, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x11, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1c,
+	0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x1a, 0x1c, 0x2e, 0x66,
+	0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x22, 0x00, 0x12, 0x7a, 0x0a, 0x10,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x28, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x66, 0x63, 0x6d,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x04, 0x53, 0x65, 0x6e, 0x64,
+	0x12, 0x1c, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x3a, 0x01,
+	0x2a, 0x22, 0x05, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x12, 0x57, 0x0a, 0x07, 0x53, 0x65, 0x6e, 0x64,
+	0x41, 0x6c, 0x6c, 0x12, 0x1f, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x13, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0d, 0x3a, 0x01, 0x2a, 0x22, 0x08, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6c,
+	0x6c, 0x12, 0x69, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61,
+	0x73, 0x74, 0x12, 0x25, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61,
+	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x73,
+	0x65, 0x6e, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x58, 0x0a, 0x0d,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x25, 0x2e,
+	0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63, 0x65, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e,
+	0x74, 0x1a, 0x18, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x12, 0x54, 0x0a,
+	0x0c, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x2e,
+	0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x43,
+	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x27,
+	0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x00, 0x12, 0x63, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x65,
+	0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x66, 0x63, 0x6d, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x56, 0x0a, 0x10, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x44,
+	0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x28, 0x2e, 0x66, 0x63, 0x6d, 0x63,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x61, 0x79, 0x44, 0x65, 0x61, 0x64, 0x4c, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x42, 0x41, 0x5a,
+	0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x65, 0x74, 0x6f,
+	0x6d, 0x61, 0x6c, 0x69, 0x6e, 0x61, 0x2f, 0x66, 0x63, 0x6d, 0x2d, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67, 0x6f, 0x2d, 0x73, 0x64, 0x6b,
+	0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1_notification_proto_rawDescOnce sync.Once
+	file_v1_notification_proto_rawDescData = file_v1_notification_proto_rawDesc
+)
+
+func file_v1_notification_proto_rawDescGZIP() []byte {
+	file_v1_notification_proto_rawDescOnce.Do(func() {
+		file_v1_notification_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_notification_proto_rawDescData)
+	})
+	return file_v1_notification_proto_rawDescData
+}
+
+var file_v1_notification_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
+var file_v1_notification_proto_goTypes = []interface{}{
+	(Platform)(0),                         // 0: fcmcompanion.v1.Platform
+	(Consent)(0),                          // 1: fcmcompanion.v1.Consent
+	(ListInstancesRequest_TokenFilter)(0), // 2: fcmcompanion.v1.ListInstancesRequest.TokenFilter
+	(ScheduledNotification_State)(0),      // 3: fcmcompanion.v1.ScheduledNotification.State
+	(Notification_State)(0),               // 4: fcmcompanion.v1.Notification.State
+	(FrequencyCap_Action)(0),              // 5: fcmcompanion.v1.FrequencyCap.Action
+	(QuietHours_Action)(0),                // 6: fcmcompanion.v1.QuietHours.Action
+	(*AppInstance)(nil),                   // 7: fcmcompanion.v1.AppInstance
+	(*RemoveTokenRequest)(nil),            // 8: fcmcompanion.v1.RemoveTokenRequest
+	(*RemoveInstanceRequest)(nil),         // 9: fcmcompanion.v1.RemoveInstanceRequest
+	(*UpdateLabelsRequest)(nil),           // 10: fcmcompanion.v1.UpdateLabelsRequest
+	(*GetInstanceRequest)(nil),            // 11: fcmcompanion.v1.GetInstanceRequest
+	(*ListInstancesRequest)(nil),          // 12: fcmcompanion.v1.ListInstancesRequest
+	(*ListUserDevicesRequest)(nil),        // 13: fcmcompanion.v1.ListUserDevicesRequest
+	(*RemoveUserRequest)(nil),             // 14: fcmcompanion.v1.RemoveUserRequest
+	(*CleanupInstancesRequest)(nil),       // 15: fcmcompanion.v1.CleanupInstancesRequest
+	(*CleanupReport)(nil),                 // 16: fcmcompanion.v1.CleanupReport
+	(*DeduplicateTokensRequest)(nil),      // 17: fcmcompanion.v1.DeduplicateTokensRequest
+	(*DeduplicateTokensReport)(nil),       // 18: fcmcompanion.v1.DeduplicateTokensReport
+	(*ImportReport)(nil),                  // 19: fcmcompanion.v1.ImportReport
+	(*ImportError)(nil),                   // 20: fcmcompanion.v1.ImportError
+	(*ExportInstancesRequest)(nil),        // 21: fcmcompanion.v1.ExportInstancesRequest
+	(*AppInstanceList)(nil),               // 22: fcmcompanion.v1.AppInstanceList
+	(*SendRequest)(nil),                   // 23: fcmcompanion.v1.SendRequest
+	(*SendAllRequest)(nil),                // 24: fcmcompanion.v1.SendAllRequest
+	(*SendMulticastRequest)(nil),          // 25: fcmcompanion.v1.SendMulticastRequest
+	(*Message)(nil),                       // 26: fcmcompanion.v1.Message
+	(*MulticastMessage)(nil),              // 27: fcmcompanion.v1.MulticastMessage
+	(*LocalTime)(nil),                     // 28: fcmcompanion.v1.LocalTime
+	(*CountAudienceRequest)(nil),          // 29: fcmcompanion.v1.CountAudienceRequest
+	(*AudienceCount)(nil),                 // 30: fcmcompanion.v1.AudienceCount
+	(*Segment)(nil),                       // 31: fcmcompanion.v1.Segment
+	(*ListSegmentsRequest)(nil),           // 32: fcmcompanion.v1.ListSegmentsRequest
+	(*SegmentList)(nil),                   // 33: fcmcompanion.v1.SegmentList
+	(*DeleteSegmentRequest)(nil),          // 34: fcmcompanion.v1.DeleteSegmentRequest
+	(*Preferences)(nil),                   // 35: fcmcompanion.v1.Preferences
+	(*CategoryPreferences)(nil),           // 36: fcmcompanion.v1.CategoryPreferences
+	(*GetPreferencesRequest)(nil),         // 37: fcmcompanion.v1.GetPreferencesRequest
+	(*ScheduledNotification)(nil),         // 38: fcmcompanion.v1.ScheduledNotification
+	(*ListScheduledRequest)(nil),          // 39: fcmcompanion.v1.ListScheduledRequest
+	(*ScheduledNotificationList)(nil),     // 40: fcmcompanion.v1.ScheduledNotificationList
+	(*CancelScheduledRequest)(nil),        // 41: fcmcompanion.v1.CancelScheduledRequest
+	(*ListNotificationsRequest)(nil),      // 42: fcmcompanion.v1.ListNotificationsRequest
+	(*NotificationList)(nil),              // 43: fcmcompanion.v1.NotificationList
+	(*Notification)(nil),                  // 44: fcmcompanion.v1.Notification
+	(*DeadLetter)(nil),                    // 45: fcmcompanion.v1.DeadLetter
+	(*ListDeadLettersRequest)(nil),        // 46: fcmcompanion.v1.ListDeadLettersRequest
+	(*DeadLetterList)(nil),                // 47: fcmcompanion.v1.DeadLetterList
+	(*ReplayDeadLetterRequest)(nil),       // 48: fcmcompanion.v1.ReplayDeadLetterRequest
+	(*NotificationConfig)(nil),            // 49: fcmcompanion.v1.NotificationConfig
+	(*FrequencyCap)(nil),                  // 50: fcmcompanion.v1.FrequencyCap
+	(*QuietHours)(nil),                    // 51: fcmcompanion.v1.QuietHours
+	(*MessageTemplate)(nil),               // 52: fcmcompanion.v1.MessageTemplate
+	(*Digest)(nil),                        // 53: fcmcompanion.v1.Digest
+	(*FCMMessage)(nil),                    // 54: fcmcompanion.v1.FCMMessage
+	(*FCMNotification)(nil),               // 55: fcmcompanion.v1.FCMNotification
+	(*FCMAndroid)(nil),                    // 56: fcmcompanion.v1.FCMAndroid
+	(*FCMAndroidNotification)(nil),        // 57: fcmcompanion.v1.FCMAndroidNotification
+	(*FCMAndroidOptions)(nil),             // 58: fcmcompanion.v1.FCMAndroidOptions
+	(*FCMWebpush)(nil),                    // 59: fcmcompanion.v1.FCMWebpush
+	(*FCMWebpushNotification)(nil),        // 60: fcmcompanion.v1.FCMWebpushNotification
+	(*FCMWebpushNotificationAction)(nil),  // 61: fcmcompanion.v1.FCMWebpushNotificationAction
+	(*FCMWebpushOptions)(nil),             // 62: fcmcompanion.v1.FCMWebpushOptions
+	(*FCMAPNSConfig)(nil),                 // 63: fcmcompanion.v1.FCMAPNSConfig
+	(*FCMOptions)(nil),                    // 64: fcmcompanion.v1.FCMOptions
+	nil,                                   // 65: fcmcompanion.v1.AppInstance.LabelsEntry
+	nil,                                   // 66: fcmcompanion.v1.UpdateLabelsRequest.SetEntry
+	nil,                                   // 67: fcmcompanion.v1.ListInstancesRequest.LabelsEntry
+	nil,                                   // 68: fcmcompanion.v1.Message.TemplateDataEntry
+	nil,                                   // 69: fcmcompanion.v1.Message.DataEntry
+	nil,                                   // 70: fcmcompanion.v1.MulticastMessage.TemplateDataEntry
+	nil,                                   // 71: fcmcompanion.v1.MulticastMessage.DataEntry
+	nil,                                   // 72: fcmcompanion.v1.Preferences.CategoriesEntry
+	nil,                                   // 73: fcmcompanion.v1.CategoryPreferences.ChannelsEntry
+	nil,                                   // 74: fcmcompanion.v1.Notification.DataEntry
+	nil,                                   // 75: fcmcompanion.v1.NotificationConfig.CategoryQuietHoursEntry
+	nil,                                   // 76: fcmcompanion.v1.NotificationConfig.CategoryFrequencyCapsEntry
+	nil,                                   // 77: fcmcompanion.v1.MessageTemplate.MessageEntry
+	nil,                                   // 78: fcmcompanion.v1.FCMMessage.DataEntry
+	nil,                                   // 79: fcmcompanion.v1.FCMAndroid.DataEntry
+	nil,                                   // 80: fcmcompanion.v1.FCMWebpush.HeadersEntry
+	nil,                                   // 81: fcmcompanion.v1.FCMWebpush.DataEntry
+	nil,                                   // 82: fcmcompanion.v1.FCMWebpushNotification.CustomDataEntry
+	(*timestamp.Timestamp)(nil),           // 83: google.protobuf.Timestamp
+	(*field_mask.FieldMask)(nil),          // 84: google.protobuf.FieldMask
+	(*duration.Duration)(nil),             // 85: google.protobuf.Duration
+	(*any1.Any)(nil),                      // 86: google.protobuf.Any
+	(*empty.Empty)(nil),                   // 87: google.protobuf.Empty
+}
+var file_v1_notification_proto_depIdxs = []int32{
+	65,  // 0: fcmcompanion.v1.AppInstance.labels:type_name -> fcmcompanion.v1.AppInstance.LabelsEntry
+	83,  // 1: fcmcompanion.v1.AppInstance.last_seen_at:type_name -> google.protobuf.Timestamp
+	0,   // 2: fcmcompanion.v1.AppInstance.platform:type_name -> fcmcompanion.v1.Platform
+	83,  // 3: fcmcompanion.v1.AppInstance.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 4: fcmcompanion.v1.AppInstance.updated_at:type_name -> google.protobuf.Timestamp
+	83,  // 5: fcmcompanion.v1.AppInstance.token_updated_at:type_name -> google.protobuf.Timestamp
+	51,  // 6: fcmcompanion.v1.AppInstance.quiet_hours:type_name -> fcmcompanion.v1.QuietHours
+	84,  // 7: fcmcompanion.v1.AppInstance.update_mask:type_name -> google.protobuf.FieldMask
+	66,  // 8: fcmcompanion.v1.UpdateLabelsRequest.set:type_name -> fcmcompanion.v1.UpdateLabelsRequest.SetEntry
+	67,  // 9: fcmcompanion.v1.ListInstancesRequest.labels:type_name -> fcmcompanion.v1.ListInstancesRequest.LabelsEntry
+	2,   // 10: fcmcompanion.v1.ListInstancesRequest.token:type_name -> fcmcompanion.v1.ListInstancesRequest.TokenFilter
+	83,  // 11: fcmcompanion.v1.ListInstancesRequest.last_seen_after:type_name -> google.protobuf.Timestamp
+	83,  // 12: fcmcompanion.v1.ListInstancesRequest.last_seen_before:type_name -> google.protobuf.Timestamp
+	0,   // 13: fcmcompanion.v1.ListInstancesRequest.platform:type_name -> fcmcompanion.v1.Platform
+	85,  // 14: fcmcompanion.v1.CleanupInstancesRequest.token_max_age:type_name -> google.protobuf.Duration
+	85,  // 15: fcmcompanion.v1.CleanupInstancesRequest.instance_max_age:type_name -> google.protobuf.Duration
+	20,  // 16: fcmcompanion.v1.ImportReport.errors:type_name -> fcmcompanion.v1.ImportError
+	7,   // 17: fcmcompanion.v1.AppInstanceList.instances:type_name -> fcmcompanion.v1.AppInstance
+	26,  // 18: fcmcompanion.v1.SendRequest.message:type_name -> fcmcompanion.v1.Message
+	26,  // 19: fcmcompanion.v1.SendAllRequest.messages:type_name -> fcmcompanion.v1.Message
+	27,  // 20: fcmcompanion.v1.SendMulticastRequest.message:type_name -> fcmcompanion.v1.MulticastMessage
+	68,  // 21: fcmcompanion.v1.Message.templateData:type_name -> fcmcompanion.v1.Message.TemplateDataEntry
+	69,  // 22: fcmcompanion.v1.Message.data:type_name -> fcmcompanion.v1.Message.DataEntry
+	83,  // 23: fcmcompanion.v1.Message.send_at:type_name -> google.protobuf.Timestamp
+	70,  // 24: fcmcompanion.v1.MulticastMessage.templateData:type_name -> fcmcompanion.v1.MulticastMessage.TemplateDataEntry
+	71,  // 25: fcmcompanion.v1.MulticastMessage.data:type_name -> fcmcompanion.v1.MulticastMessage.DataEntry
+	83,  // 26: fcmcompanion.v1.MulticastMessage.send_at:type_name -> google.protobuf.Timestamp
+	28,  // 27: fcmcompanion.v1.MulticastMessage.local_time:type_name -> fcmcompanion.v1.LocalTime
+	83,  // 28: fcmcompanion.v1.Segment.created_at:type_name -> google.protobuf.Timestamp
+	31,  // 29: fcmcompanion.v1.SegmentList.segments:type_name -> fcmcompanion.v1.Segment
+	72,  // 30: fcmcompanion.v1.Preferences.categories:type_name -> fcmcompanion.v1.Preferences.CategoriesEntry
+	83,  // 31: fcmcompanion.v1.Preferences.updated_at:type_name -> google.protobuf.Timestamp
+	1,   // 32: fcmcompanion.v1.CategoryPreferences.consent:type_name -> fcmcompanion.v1.Consent
+	73,  // 33: fcmcompanion.v1.CategoryPreferences.channels:type_name -> fcmcompanion.v1.CategoryPreferences.ChannelsEntry
+	83,  // 34: fcmcompanion.v1.ScheduledNotification.send_at:type_name -> google.protobuf.Timestamp
+	3,   // 35: fcmcompanion.v1.ScheduledNotification.state:type_name -> fcmcompanion.v1.ScheduledNotification.State
+	83,  // 36: fcmcompanion.v1.ScheduledNotification.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 37: fcmcompanion.v1.ScheduledNotification.due_at:type_name -> google.protobuf.Timestamp
+	3,   // 38: fcmcompanion.v1.ListScheduledRequest.state:type_name -> fcmcompanion.v1.ScheduledNotification.State
+	38,  // 39: fcmcompanion.v1.ScheduledNotificationList.notifications:type_name -> fcmcompanion.v1.ScheduledNotification
+	7,   // 40: fcmcompanion.v1.ListNotificationsRequest.filter:type_name -> fcmcompanion.v1.AppInstance
+	4,   // 41: fcmcompanion.v1.ListNotificationsRequest.state:type_name -> fcmcompanion.v1.Notification.State
+	44,  // 42: fcmcompanion.v1.NotificationList.notifications:type_name -> fcmcompanion.v1.Notification
+	7,   // 43: fcmcompanion.v1.Notification.instance:type_name -> fcmcompanion.v1.AppInstance
+	74,  // 44: fcmcompanion.v1.Notification.data:type_name -> fcmcompanion.v1.Notification.DataEntry
+	54,  // 45: fcmcompanion.v1.Notification.message:type_name -> fcmcompanion.v1.FCMMessage
+	4,   // 46: fcmcompanion.v1.Notification.state:type_name -> fcmcompanion.v1.Notification.State
+	83,  // 47: fcmcompanion.v1.Notification.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 48: fcmcompanion.v1.DeadLetter.created_at:type_name -> google.protobuf.Timestamp
+	45,  // 49: fcmcompanion.v1.DeadLetterList.dead_letters:type_name -> fcmcompanion.v1.DeadLetter
+	52,  // 50: fcmcompanion.v1.NotificationConfig.messages:type_name -> fcmcompanion.v1.MessageTemplate
+	51,  // 51: fcmcompanion.v1.NotificationConfig.quiet_hours:type_name -> fcmcompanion.v1.QuietHours
+	75,  // 52: fcmcompanion.v1.NotificationConfig.category_quiet_hours:type_name -> fcmcompanion.v1.NotificationConfig.CategoryQuietHoursEntry
+	76,  // 53: fcmcompanion.v1.NotificationConfig.category_frequency_caps:type_name -> fcmcompanion.v1.NotificationConfig.CategoryFrequencyCapsEntry
+	5,   // 54: fcmcompanion.v1.FrequencyCap.action:type_name -> fcmcompanion.v1.FrequencyCap.Action
+	6,   // 55: fcmcompanion.v1.QuietHours.action:type_name -> fcmcompanion.v1.QuietHours.Action
+	77,  // 56: fcmcompanion.v1.MessageTemplate.message:type_name -> fcmcompanion.v1.MessageTemplate.MessageEntry
+	54,  // 57: fcmcompanion.v1.MessageTemplate.fcm_message:type_name -> fcmcompanion.v1.FCMMessage
+	50,  // 58: fcmcompanion.v1.MessageTemplate.frequency_cap:type_name -> fcmcompanion.v1.FrequencyCap
+	53,  // 59: fcmcompanion.v1.MessageTemplate.digest:type_name -> fcmcompanion.v1.Digest
+	78,  // 60: fcmcompanion.v1.FCMMessage.data:type_name -> fcmcompanion.v1.FCMMessage.DataEntry
+	55,  // 61: fcmcompanion.v1.FCMMessage.notification:type_name -> fcmcompanion.v1.FCMNotification
+	56,  // 62: fcmcompanion.v1.FCMMessage.android:type_name -> fcmcompanion.v1.FCMAndroid
+	59,  // 63: fcmcompanion.v1.FCMMessage.webpush:type_name -> fcmcompanion.v1.FCMWebpush
+	63,  // 64: fcmcompanion.v1.FCMMessage.apns:type_name -> fcmcompanion.v1.FCMAPNSConfig
+	64,  // 65: fcmcompanion.v1.FCMMessage.fcm_options:type_name -> fcmcompanion.v1.FCMOptions
+	83,  // 66: fcmcompanion.v1.FCMAndroid.ttl:type_name -> google.protobuf.Timestamp
+	79,  // 67: fcmcompanion.v1.FCMAndroid.data:type_name -> fcmcompanion.v1.FCMAndroid.DataEntry
+	57,  // 68: fcmcompanion.v1.FCMAndroid.notification:type_name -> fcmcompanion.v1.FCMAndroidNotification
+	58,  // 69: fcmcompanion.v1.FCMAndroid.fcm_options:type_name -> fcmcompanion.v1.FCMAndroidOptions
+	80,  // 70: fcmcompanion.v1.FCMWebpush.headers:type_name -> fcmcompanion.v1.FCMWebpush.HeadersEntry
+	81,  // 71: fcmcompanion.v1.FCMWebpush.data:type_name -> fcmcompanion.v1.FCMWebpush.DataEntry
+	60,  // 72: fcmcompanion.v1.FCMWebpush.notification:type_name -> fcmcompanion.v1.FCMWebpushNotification
+	62,  // 73: fcmcompanion.v1.FCMWebpush.fcm_options:type_name -> fcmcompanion.v1.FCMWebpushOptions
+	61,  // 74: fcmcompanion.v1.FCMWebpushNotification.actions:type_name -> fcmcompanion.v1.FCMWebpushNotificationAction
+	86,  // 75: fcmcompanion.v1.FCMWebpushNotification.data:type_name -> google.protobuf.Any
+	82,  // 76: fcmcompanion.v1.FCMWebpushNotification.custom_data:type_name -> fcmcompanion.v1.FCMWebpushNotification.CustomDataEntry
+	36,  // 77: fcmcompanion.v1.Preferences.CategoriesEntry.value:type_name -> fcmcompanion.v1.CategoryPreferences
+	1,   // 78: fcmcompanion.v1.CategoryPreferences.ChannelsEntry.value:type_name -> fcmcompanion.v1.Consent
+	51,  // 79: fcmcompanion.v1.NotificationConfig.CategoryQuietHoursEntry.value:type_name -> fcmcompanion.v1.QuietHours
+	50,  // 80: fcmcompanion.v1.NotificationConfig.CategoryFrequencyCapsEntry.value:type_name -> fcmcompanion.v1.FrequencyCap
+	86,  // 81: fcmcompanion.v1.MessageTemplate.MessageEntry.value:type_name -> google.protobuf.Any
+	86,  // 82: fcmcompanion.v1.FCMWebpushNotification.CustomDataEntry.value:type_name -> google.protobuf.Any
+	7,   // 83: fcmcompanion.v1.NotificationService.PutInstance:input_type -> fcmcompanion.v1.AppInstance
+	8,   // 84: fcmcompanion.v1.NotificationService.RemoveToken:input_type -> fcmcompanion.v1.RemoveTokenRequest
+	9,   // 85: fcmcompanion.v1.NotificationService.RemoveInstance:input_type -> fcmcompanion.v1.RemoveInstanceRequest
+	10,  // 86: fcmcompanion.v1.NotificationService.UpdateLabels:input_type -> fcmcompanion.v1.UpdateLabelsRequest
+	17,  // 87: fcmcompanion.v1.NotificationService.DeduplicateTokens:input_type -> fcmcompanion.v1.DeduplicateTokensRequest
+	7,   // 88: fcmcompanion.v1.NotificationService.ImportInstances:input_type -> fcmcompanion.v1.AppInstance
+	21,  // 89: fcmcompanion.v1.NotificationService.ExportInstances:input_type -> fcmcompanion.v1.ExportInstancesRequest
+	11,  // 90: fcmcompanion.v1.NotificationService.GetInstance:input_type -> fcmcompanion.v1.GetInstanceRequest
+	12,  // 91: fcmcompanion.v1.NotificationService.ListInstances:input_type -> fcmcompanion.v1.ListInstancesRequest
+	13,  // 92: fcmcompanion.v1.NotificationService.ListUserDevices:input_type -> fcmcompanion.v1.ListUserDevicesRequest
+	14,  // 93: fcmcompanion.v1.NotificationService.RemoveUser:input_type -> fcmcompanion.v1.RemoveUserRequest
+	37,  // 94: fcmcompanion.v1.NotificationService.GetPreferences:input_type -> fcmcompanion.v1.GetPreferencesRequest
+	35,  // 95: fcmcompanion.v1.NotificationService.UpdatePreferences:input_type -> fcmcompanion.v1.Preferences
+	15,  // 96: fcmcompanion.v1.NotificationService.CleanupInstances:input_type -> fcmcompanion.v1.CleanupInstancesRequest
+	23,  // 97: fcmcompanion.v1.NotificationService.Send:input_type -> fcmcompanion.v1.SendRequest
+	24,  // 98: fcmcompanion.v1.NotificationService.SendAll:input_type -> fcmcompanion.v1.SendAllRequest
+	25,  // 99: fcmcompanion.v1.NotificationService.SendMulticast:input_type -> fcmcompanion.v1.SendMulticastRequest
+	29,  // 100: fcmcompanion.v1.NotificationService.CountAudience:input_type -> fcmcompanion.v1.CountAudienceRequest
+	31,  // 101: fcmcompanion.v1.NotificationService.CreateSegment:input_type -> fcmcompanion.v1.Segment
+	32,  // 102: fcmcompanion.v1.NotificationService.ListSegments:input_type -> fcmcompanion.v1.ListSegmentsRequest
+	34,  // 103: fcmcompanion.v1.NotificationService.DeleteSegment:input_type -> fcmcompanion.v1.DeleteSegmentRequest
+	39,  // 104: fcmcompanion.v1.NotificationService.ListScheduled:input_type -> fcmcompanion.v1.ListScheduledRequest
+	41,  // 105: fcmcompanion.v1.NotificationService.CancelScheduled:input_type -> fcmcompanion.v1.CancelScheduledRequest
+	42,  // 106: fcmcompanion.v1.NotificationService.ListNotifications:input_type -> fcmcompanion.v1.ListNotificationsRequest
+	46,  // 107: fcmcompanion.v1.NotificationService.ListDeadLetters:input_type -> fcmcompanion.v1.ListDeadLettersRequest
+	48,  // 108: fcmcompanion.v1.NotificationService.ReplayDeadLetter:input_type -> fcmcompanion.v1.ReplayDeadLetterRequest
+	87,  // 109: fcmcompanion.v1.NotificationService.PutInstance:output_type -> google.protobuf.Empty
+	87,  // 110: fcmcompanion.v1.NotificationService.RemoveToken:output_type -> google.protobuf.Empty
+	87,  // 111: fcmcompanion.v1.NotificationService.RemoveInstance:output_type -> google.protobuf.Empty
+	87,  // 112: fcmcompanion.v1.NotificationService.UpdateLabels:output_type -> google.protobuf.Empty
+	18,  // 113: fcmcompanion.v1.NotificationService.DeduplicateTokens:output_type -> fcmcompanion.v1.DeduplicateTokensReport
+	19,  // 114: fcmcompanion.v1.NotificationService.ImportInstances:output_type -> fcmcompanion.v1.ImportReport
+	7,   // 115: fcmcompanion.v1.NotificationService.ExportInstances:output_type -> fcmcompanion.v1.AppInstance
+	7,   // 116: fcmcompanion.v1.NotificationService.GetInstance:output_type -> fcmcompanion.v1.AppInstance
+	22,  // 117: fcmcompanion.v1.NotificationService.ListInstances:output_type -> fcmcompanion.v1.AppInstanceList
+	22,  // 118: fcmcompanion.v1.NotificationService.ListUserDevices:output_type -> fcmcompanion.v1.AppInstanceList
+	87,  // 119: fcmcompanion.v1.NotificationService.RemoveUser:output_type -> google.protobuf.Empty
+	35,  // 120: fcmcompanion.v1.NotificationService.GetPreferences:output_type -> fcmcompanion.v1.Preferences
+	35,  // 121: fcmcompanion.v1.NotificationService.UpdatePreferences:output_type -> fcmcompanion.v1.Preferences
+	16,  // 122: fcmcompanion.v1.NotificationService.CleanupInstances:output_type -> fcmcompanion.v1.CleanupReport
+	87,  // 123: fcmcompanion.v1.NotificationService.Send:output_type -> google.protobuf.Empty
+	87,  // 124: fcmcompanion.v1.NotificationService.SendAll:output_type -> google.protobuf.Empty
+	87,  // 125: fcmcompanion.v1.NotificationService.SendMulticast:output_type -> google.protobuf.Empty
+	30,  // 126: fcmcompanion.v1.NotificationService.CountAudience:output_type -> fcmcompanion.v1.AudienceCount
+	31,  // 127: fcmcompanion.v1.NotificationService.CreateSegment:output_type -> fcmcompanion.v1.Segment
+	33,  // 128: fcmcompanion.v1.NotificationService.ListSegments:output_type -> fcmcompanion.v1.SegmentList
+	87,  // 129: fcmcompanion.v1.NotificationService.DeleteSegment:output_type -> google.protobuf.Empty
+	40,  // 130: fcmcompanion.v1.NotificationService.ListScheduled:output_type -> fcmcompanion.v1.ScheduledNotificationList
+	87,  // 131: fcmcompanion.v1.NotificationService.CancelScheduled:output_type -> google.protobuf.Empty
+	43,  // 132: fcmcompanion.v1.NotificationService.ListNotifications:output_type -> fcmcompanion.v1.NotificationList
+	47,  // 133: fcmcompanion.v1.NotificationService.ListDeadLetters:output_type -> fcmcompanion.v1.DeadLetterList
+	87,  // 134: fcmcompanion.v1.NotificationService.ReplayDeadLetter:output_type -> google.protobuf.Empty
+	109, // [109:135] is the sub-list for method output_type
+	83,  // [83:109] is the sub-list for method input_type
+	83,  // [83:83] is the sub-list for extension type_name
+	83,  // [83:83] is the sub-list for extension extendee
+	0,   // [0:83] is the sub-list for field type_name
+}
+
+func init() { file_v1_notification_proto_init() }
+func file_v1_notification_proto_init() {
+	if File_v1_notification_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_v1_notification_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveInstanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateLabelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListInstancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserDevicesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveUserRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanupInstancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanupReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeduplicateTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeduplicateTokensReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportInstancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppInstanceList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendAllRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMulticastRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MulticastMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountAudienceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AudienceCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Segment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSegmentsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SegmentList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FCMOptions.ProtoReflect.Descriptor instead.
-func (*FCMOptions) Descriptor() ([]byte, []int) {
-	return file_v1_notification_proto_rawDescGZIP(), []int{20}
-}
-
-var File_v1_notification_proto protoreflect.FileDescriptor
-
-var file_v1_notification_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0f, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xdc, 0x01, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x12, 0x40, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65,
-	0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e,
-	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61,
-	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x35, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x15,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74,
-	0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x46, 0x0a, 0x0e, 0x53, 0x65, 0x6e,
-	0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x22, 0x53, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x66, 0x63, 0x6d,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf6, 0x02, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x49, 0x64, 0x12, 0x4e, 0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x66, 0x63, 0x6d, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x36, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0xdf, 0x02, 0x0a, 0x10, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x66, 0x63,
-	0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x75,
-	0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0c, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3f,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x66,
-	0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d,
-	0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
-	0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x8c, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34,
-	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x06, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
-	0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x7f, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x63,
-	0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78,
-	0x74, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x22, 0xf5, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x63, 0x6d,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x63, 0x6d,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x52, 0x0a, 0x12, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x3c, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x20, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c,
-	0x61, 0x74, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xbc, 0x01,
-	0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x47, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70,
-	0x6c, 0x61, 0x74, 0x65, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x50, 0x0a, 0x0c, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e,
-	0x79, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xea, 0x03, 0x0a,
-	0x0a, 0x46, 0x43, 0x4d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x63, 0x6d, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x44, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66,
-	0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46,
-	0x43, 0x4d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x07,
-	0x61, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x43, 0x4d, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x52, 0x07, 0x61, 0x6e, 0x64, 0x72,
-	0x6f, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x07, 0x77, 0x65, 0x62, 0x70, 0x75, 0x73, 0x68, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x57, 0x65, 0x62, 0x70, 0x75, 0x73,
-	0x68, 0x52, 0x07, 0x77, 0x65, 0x62, 0x70, 0x75, 0x73, 0x68, 0x12, 0x2c, 0x0a, 0x04, 0x61, 0x70,
-	0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x41, 0x50,
-	0x4e, 0x53, 0x52, 0x04, 0x61, 0x70, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x66, 0x63, 0x6d, 0x5f,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
-	0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x43, 0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x66, 0x63, 0x6d, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70,
-	0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x58, 0x0a, 0x0f, 0x46, 0x43, 0x4d,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
-	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x55, 0x72, 0x6c, 0x22, 0xb7, 0x03, 0x0a, 0x0a, 0x46, 0x43, 0x4d, 0x41, 0x6e, 0x64, 0x72, 0x6f,
-	0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x5f, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x70,
-	0x73, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x2c, 0x0a, 0x03, 0x74, 0x74, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12,
-	0x36, 0x0a, 0x17, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61,
-	0x63, 0x6b, 0x61, 0x67, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x15, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x41, 0x6e, 0x64, 0x72, 0x6f,
-	0x69, 0x64, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x4b, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f,
-	0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x41, 0x6e,
-	0x64, 0x72, 0x6f, 0x69, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x43, 0x0a, 0x0b, 0x66, 0x63, 0x6d, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x43, 0x4d, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69,
-	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x66, 0x63, 0x6d, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x37, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x83, 0x03,
-	0x0a, 0x16, 0x46, 0x43, 0x4d, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69, 0x64, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f,
-	0x64, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x69, 0x63, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x6f, 0x75,
-	0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x74, 0x61, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x69, 0x63, 0x6b, 0x5f, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x63,
-	0x6b, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x62, 0x6f, 0x64, 0x79, 0x5f,
-	0x6c, 0x6f, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
-	0x6f, 0x64, 0x79, 0x4c, 0x6f, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6f, 0x64,
-	0x79, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0b, 0x62, 0x6f, 0x64, 0x79, 0x4c, 0x6f, 0x63, 0x41, 0x72, 0x67, 0x73, 0x12, 0x22, 0x0a,
-	0x0d, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x4c, 0x6f, 0x63, 0x4b, 0x65,
-	0x79, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x5f, 0x61,
-	0x72, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x4c, 0x6f, 0x63, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x55, 0x72, 0x6c, 0x22, 0x3c, 0x0a, 0x11, 0x46, 0x43, 0x4d, 0x41, 0x6e, 0x64, 0x72, 0x6f, 0x69,
-	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x6e, 0x61, 0x6c,
-	0x79, 0x74, 0x69, 0x63, 0x73, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0e, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x4c, 0x61, 0x62, 0x65,
-	0x6c, 0x22, 0x0c, 0x0a, 0x0a, 0x46, 0x43, 0x4d, 0x57, 0x65, 0x62, 0x70, 0x75, 0x73, 0x68, 0x22,
-	0x09, 0x0a, 0x07, 0x46, 0x43, 0x4d, 0x41, 0x50, 0x4e, 0x53, 0x22, 0x0c, 0x0a, 0x0a, 0x46, 0x43,
-	0x4d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0xf7, 0x04, 0x0a, 0x13, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x45, 0x0a, 0x0b, 0x50, 0x75, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12,
-	0x1c, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d,
-	0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x04, 0x53, 0x65, 0x6e,
-	0x64, 0x12, 0x1c, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x22,
-	0x05, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x57, 0x0a, 0x07, 0x53, 0x65, 0x6e,
-	0x64, 0x41, 0x6c, 0x6c, 0x12, 0x1f, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x13, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x22, 0x08, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x41, 0x6c, 0x6c, 0x3a,
-	0x01, 0x2a, 0x12, 0x69, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63,
-	0x61, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63,
-	0x61, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x73, 0x65, 0x6e,
-	0x64, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x63, 0x0a,
-	0x11, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x29, 0x2e, 0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
-	0x66, 0x63, 0x6d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74,
-	0x22, 0x00, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x70, 0x65, 0x74, 0x6f, 0x6d, 0x61, 0x6c, 0x69, 0x6e, 0x61, 0x2f, 0x66, 0x63, 0x6d, 0x2d,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x70, 0x69, 0x73, 0x2f, 0x67,
-	0x6f, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_v1_notification_proto_rawDescOnce sync.Once
-	file_v1_notification_proto_rawDescData = file_v1_notification_proto_rawDesc
-)
-
-func file_v1_notification_proto_rawDescGZIP() []byte {
-	file_v1_notification_proto_rawDescOnce.Do(func() {
-		file_v1_notification_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_notification_proto_rawDescData)
-	})
-	return file_v1_notification_proto_rawDescData
-}
-
-var file_v1_notification_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
-var file_v1_notification_proto_goTypes = []interface{}{
-	(*AppInstance)(nil),              // 0: fcmcompanion.v1.AppInstance
-	(*RemoveTokenRequest)(nil),       // 1: fcmcompanion.v1.RemoveTokenRequest
-	(*RemoveInstanceRequest)(nil),    // 2: fcmcompanion.v1.RemoveInstanceRequest
-	(*SendRequest)(nil),              // 3: fcmcompanion.v1.SendRequest
-	(*SendAllRequest)(nil),           // 4: fcmcompanion.v1.SendAllRequest
-	(*SendMulticastRequest)(nil),     // 5: fcmcompanion.v1.SendMulticastRequest
-	(*Message)(nil),                  // 6: fcmcompanion.v1.Message
-	(*MulticastMessage)(nil),         // 7: fcmcompanion.v1.MulticastMessage
-	(*ListNotificationsRequest)(nil), // 8: fcmcompanion.v1.ListNotificationsRequest
-	(*NotificationList)(nil),         // 9: fcmcompanion.v1.NotificationList
-	(*Notification)(nil),             // 10: fcmcompanion.v1.Notification
-	(*NotificationConfig)(nil),       // 11: fcmcompanion.v1.NotificationConfig
-	(*MessageTemplate)(nil),          // 12: fcmcompanion.v1.MessageTemplate
-	(*FCMMessage)(nil),               // 13: fcmcompanion.v1.FCMMessage
-	(*FCMNotification)(nil),          // 14: fcmcompanion.v1.FCMNotification
-	(*FCMAndroid)(nil),               // 15: fcmcompanion.v1.FCMAndroid
-	(*FCMAndroidNotification)(nil),   // 16: fcmcompanion.v1.FCMAndroidNotification
-	(*FCMAndroidOptions)(nil),        // 17: fcmcompanion.v1.FCMAndroidOptions
-	(*FCMWebpush)(nil),               // 18: fcmcompanion.v1.FCMWebpush
-	(*FCMAPNS)(nil),                  // 19: fcmcompanion.v1.FCMAPNS
-	(*FCMOptions)(nil),               // 20: fcmcompanion.v1.FCMOptions
-	nil,                              // 21: fcmcompanion.v1.AppInstance.LabelsEntry
-	nil,                              // 22: fcmcompanion.v1.Message.TemplateDataEntry
-	nil,                              // 23: fcmcompanion.v1.Message.DataEntry
-	nil,                              // 24: fcmcompanion.v1.MulticastMessage.TemplateDataEntry
-	nil,                              // 25: fcmcompanion.v1.MulticastMessage.DataEntry
-	nil,                              // 26: fcmcompanion.v1.Notification.DataEntry
-	nil,                              // 27: fcmcompanion.v1.MessageTemplate.MessageEntry
-	nil,                              // 28: fcmcompanion.v1.FCMMessage.DataEntry
-	nil,                              // 29: fcmcompanion.v1.FCMAndroid.DataEntry
-	(*timestamp.Timestamp)(nil),      // 30: google.protobuf.Timestamp
-	(*any.Any)(nil),                  // 31: google.protobuf.Any
-	(*empty.Empty)(nil),              // 32: google.protobuf.Empty
-}
-var file_v1_notification_proto_depIdxs = []int32{
-	21, // 0: fcmcompanion.v1.AppInstance.labels:type_name -> fcmcompanion.v1.AppInstance.LabelsEntry
-	6,  // 1: fcmcompanion.v1.SendRequest.message:type_name -> fcmcompanion.v1.Message
-	6,  // 2: fcmcompanion.v1.SendAllRequest.messages:type_name -> fcmcompanion.v1.Message
-	7,  // 3: fcmcompanion.v1.SendMulticastRequest.message:type_name -> fcmcompanion.v1.MulticastMessage
-	22, // 4: fcmcompanion.v1.Message.templateData:type_name -> fcmcompanion.v1.Message.TemplateDataEntry
-	23, // 5: fcmcompanion.v1.Message.data:type_name -> fcmcompanion.v1.Message.DataEntry
-	24, // 6: fcmcompanion.v1.MulticastMessage.templateData:type_name -> fcmcompanion.v1.MulticastMessage.TemplateDataEntry
-	25, // 7: fcmcompanion.v1.MulticastMessage.data:type_name -> fcmcompanion.v1.MulticastMessage.DataEntry
-	0,  // 8: fcmcompanion.v1.ListNotificationsRequest.filter:type_name -> fcmcompanion.v1.AppInstance
-	10, // 9: fcmcompanion.v1.NotificationList.notifications:type_name -> fcmcompanion.v1.Notification
-	0,  // 10: fcmcompanion.v1.Notification.instance:type_name -> fcmcompanion.v1.AppInstance
-	26, // 11: fcmcompanion.v1.Notification.data:type_name -> fcmcompanion.v1.Notification.DataEntry
-	13, // 12: fcmcompanion.v1.Notification.message:type_name -> fcmcompanion.v1.FCMMessage
-	12, // 13: fcmcompanion.v1.NotificationConfig.messages:type_name -> fcmcompanion.v1.MessageTemplate
-	27, // 14: fcmcompanion.v1.MessageTemplate.message:type_name -> fcmcompanion.v1.MessageTemplate.MessageEntry
-	28, // 15: fcmcompanion.v1.FCMMessage.data:type_name -> fcmcompanion.v1.FCMMessage.DataEntry
-	14, // 16: fcmcompanion.v1.FCMMessage.notification:type_name -> fcmcompanion.v1.FCMNotification
-	15, // 17: fcmcompanion.v1.FCMMessage.android:type_name -> fcmcompanion.v1.FCMAndroid
-	18, // 18: fcmcompanion.v1.FCMMessage.webpush:type_name -> fcmcompanion.v1.FCMWebpush
-	19, // 19: fcmcompanion.v1.FCMMessage.apns:type_name -> fcmcompanion.v1.FCMAPNS
-	20, // 20: fcmcompanion.v1.FCMMessage.fcm_options:type_name -> fcmcompanion.v1.FCMOptions
-	30, // 21: fcmcompanion.v1.FCMAndroid.ttl:type_name -> google.protobuf.Timestamp
-	29, // 22: fcmcompanion.v1.FCMAndroid.data:type_name -> fcmcompanion.v1.FCMAndroid.DataEntry
-	16, // 23: fcmcompanion.v1.FCMAndroid.notification:type_name -> fcmcompanion.v1.FCMAndroidNotification
-	17, // 24: fcmcompanion.v1.FCMAndroid.fcm_options:type_name -> fcmcompanion.v1.FCMAndroidOptions
-	31, // 25: fcmcompanion.v1.MessageTemplate.MessageEntry.value:type_name -> google.protobuf.Any
-	0,  // 26: fcmcompanion.v1.NotificationService.PutInstance:input_type -> fcmcompanion.v1.AppInstance
-	1,  // 27: fcmcompanion.v1.NotificationService.RemoveToken:input_type -> fcmcompanion.v1.RemoveTokenRequest
-	2,  // 28: fcmcompanion.v1.NotificationService.RemoveInstance:input_type -> fcmcompanion.v1.RemoveInstanceRequest
-	3,  // 29: fcmcompanion.v1.NotificationService.Send:input_type -> fcmcompanion.v1.SendRequest
-	4,  // 30: fcmcompanion.v1.NotificationService.SendAll:input_type -> fcmcompanion.v1.SendAllRequest
-	5,  // 31: fcmcompanion.v1.NotificationService.SendMulticast:input_type -> fcmcompanion.v1.SendMulticastRequest
-	8,  // 32: fcmcompanion.v1.NotificationService.ListNotifications:input_type -> fcmcompanion.v1.ListNotificationsRequest
-	32, // 33: fcmcompanion.v1.NotificationService.PutInstance:output_type -> google.protobuf.Empty
-	32, // 34: fcmcompanion.v1.NotificationService.RemoveToken:output_type -> google.protobuf.Empty
-	32, // 35: fcmcompanion.v1.NotificationService.RemoveInstance:output_type -> google.protobuf.Empty
-	32, // 36: fcmcompanion.v1.NotificationService.Send:output_type -> google.protobuf.Empty
-	32, // 37: fcmcompanion.v1.NotificationService.SendAll:output_type -> google.protobuf.Empty
-	32, // 38: fcmcompanion.v1.NotificationService.SendMulticast:output_type -> google.protobuf.Empty
-	9,  // 39: fcmcompanion.v1.NotificationService.ListNotifications:output_type -> fcmcompanion.v1.NotificationList
-	33, // [33:40] is the sub-list for method output_type
-	26, // [26:33] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
-}
-
-func init() { file_v1_notification_proto_init() }
-func file_v1_notification_proto_init() {
-	if File_v1_notification_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_v1_notification_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AppInstance); i {
+		file_v1_notification_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSegmentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1833,8 +6457,8 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveTokenRequest); i {
+		file_v1_notification_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Preferences); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1845,8 +6469,8 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveInstanceRequest); i {
+		file_v1_notification_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CategoryPreferences); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1857,8 +6481,8 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendRequest); i {
+		file_v1_notification_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPreferencesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1869,8 +6493,8 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendAllRequest); i {
+		file_v1_notification_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1881,8 +6505,8 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendMulticastRequest); i {
+		file_v1_notification_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListScheduledRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1893,8 +6517,8 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
+		file_v1_notification_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledNotificationList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1905,8 +6529,8 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MulticastMessage); i {
+		file_v1_notification_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1917,7 +6541,7 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_notification_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ListNotificationsRequest); i {
 			case 0:
 				return &v.state
@@ -1929,7 +6553,7 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_notification_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotificationList); i {
 			case 0:
 				return &v.state
@@ -1941,7 +6565,7 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_notification_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Notification); i {
 			case 0:
 				return &v.state
@@ -1953,7 +6577,55 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_notification_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDeadLettersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeadLetterList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayDeadLetterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotificationConfig); i {
 			case 0:
 				return &v.state
@@ -1965,7 +6637,31 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_notification_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FrequencyCap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuietHours); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MessageTemplate); i {
 			case 0:
 				return &v.state
@@ -1977,7 +6673,19 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_notification_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Digest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FCMMessage); i {
 			case 0:
 				return &v.state
@@ -1989,7 +6697,7 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_notification_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FCMNotification); i {
 			case 0:
 				return &v.state
@@ -2001,7 +6709,7 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_notification_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FCMAndroid); i {
 			case 0:
 				return &v.state
@@ -2013,7 +6721,7 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_notification_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FCMAndroidNotification); i {
 			case 0:
 				return &v.state
@@ -2025,7 +6733,7 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_notification_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FCMAndroidOptions); i {
 			case 0:
 				return &v.state
@@ -2037,7 +6745,7 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_notification_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FCMWebpush); i {
 			case 0:
 				return &v.state
@@ -2049,8 +6757,8 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FCMAPNS); i {
+		file_v1_notification_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FCMWebpushNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2061,7 +6769,43 @@ func file_v1_notification_proto_init() {
 				return nil
 			}
 		}
-		file_v1_notification_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_notification_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FCMWebpushNotificationAction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FCMWebpushOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FCMAPNSConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_notification_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FCMOptions); i {
 			case 0:
 				return &v.state
@@ -2079,13 +6823,14 @@ func file_v1_notification_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_notification_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   30,
+			NumEnums:      7,
+			NumMessages:   76,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_v1_notification_proto_goTypes,
 		DependencyIndexes: file_v1_notification_proto_depIdxs,
+		EnumInfos:         file_v1_notification_proto_enumTypes,
 		MessageInfos:      file_v1_notification_proto_msgTypes,
 	}.Build()
 	File_v1_notification_proto = out.File
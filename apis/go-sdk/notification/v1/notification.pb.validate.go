@@ -57,6 +57,81 @@ func (m *AppInstance) Validate() error {
 
 	// no validation rules for Labels
 
+	if v, ok := interface{}(m.GetLastSeenAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppInstanceValidationError{
+				field:  "LastSeenAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := Platform_name[int32(m.GetPlatform())]; !ok {
+		return AppInstanceValidationError{
+			field:  "Platform",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for AppVersion
+
+	// no validation rules for OsVersion
+
+	// no validation rules for SdkVersion
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppInstanceValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppInstanceValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetTokenUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppInstanceValidationError{
+				field:  "TokenUpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timezone
+
+	if v, ok := interface{}(m.GetQuietHours()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppInstanceValidationError{
+				field:  "QuietHours",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppInstanceValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -252,30 +327,31 @@ var _ interface {
 	ErrorName() string
 } = RemoveInstanceRequestValidationError{}
 
-// Validate checks the field values on SendRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *SendRequest) Validate() error {
+// Validate checks the field values on UpdateLabelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *UpdateLabelsRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return SendRequestValidationError{
-				field:  "Message",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+	if utf8.RuneCountInString(m.GetInstanceId()) < 8 {
+		return UpdateLabelsRequestValidationError{
+			field:  "InstanceId",
+			reason: "value length must be at least 8 runes",
 		}
 	}
 
+	// no validation rules for Set
+
+	// no validation rules for Replace
+
 	return nil
 }
 
-// SendRequestValidationError is the validation error returned by
-// SendRequest.Validate if the designated constraints aren't met.
-type SendRequestValidationError struct {
+// UpdateLabelsRequestValidationError is the validation error returned by
+// UpdateLabelsRequest.Validate if the designated constraints aren't met.
+type UpdateLabelsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -283,22 +359,24 @@ type SendRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SendRequestValidationError) Field() string { return e.field }
+func (e UpdateLabelsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SendRequestValidationError) Reason() string { return e.reason }
+func (e UpdateLabelsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SendRequestValidationError) Cause() error { return e.cause }
+func (e UpdateLabelsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SendRequestValidationError) Key() bool { return e.key }
+func (e UpdateLabelsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SendRequestValidationError) ErrorName() string { return "SendRequestValidationError" }
+func (e UpdateLabelsRequestValidationError) ErrorName() string {
+	return "UpdateLabelsRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SendRequestValidationError) Error() string {
+func (e UpdateLabelsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -310,14 +388,14 @@ func (e SendRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSendRequest.%s: %s%s",
+		"invalid %sUpdateLabelsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SendRequestValidationError{}
+var _ error = UpdateLabelsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -325,37 +403,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SendRequestValidationError{}
+} = UpdateLabelsRequestValidationError{}
 
-// Validate checks the field values on SendAllRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, an error
-// is returned.
-func (m *SendAllRequest) Validate() error {
+// Validate checks the field values on GetInstanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetInstanceRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetMessages() {
-		_, _ = idx, item
-
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return SendAllRequestValidationError{
-					field:  fmt.Sprintf("Messages[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetInstanceId()) < 8 {
+		return GetInstanceRequestValidationError{
+			field:  "InstanceId",
+			reason: "value length must be at least 8 runes",
 		}
-
 	}
 
 	return nil
 }
 
-// SendAllRequestValidationError is the validation error returned by
-// SendAllRequest.Validate if the designated constraints aren't met.
-type SendAllRequestValidationError struct {
+// GetInstanceRequestValidationError is the validation error returned by
+// GetInstanceRequest.Validate if the designated constraints aren't met.
+type GetInstanceRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -363,22 +433,24 @@ type SendAllRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SendAllRequestValidationError) Field() string { return e.field }
+func (e GetInstanceRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SendAllRequestValidationError) Reason() string { return e.reason }
+func (e GetInstanceRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SendAllRequestValidationError) Cause() error { return e.cause }
+func (e GetInstanceRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SendAllRequestValidationError) Key() bool { return e.key }
+func (e GetInstanceRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SendAllRequestValidationError) ErrorName() string { return "SendAllRequestValidationError" }
+func (e GetInstanceRequestValidationError) ErrorName() string {
+	return "GetInstanceRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e SendAllRequestValidationError) Error() string {
+func (e GetInstanceRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -390,14 +462,14 @@ func (e SendAllRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSendAllRequest.%s: %s%s",
+		"invalid %sGetInstanceRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SendAllRequestValidationError{}
+var _ error = GetInstanceRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -405,32 +477,61 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SendAllRequestValidationError{}
+} = GetInstanceRequestValidationError{}
 
-// Validate checks the field values on SendMulticastRequest with the rules
+// Validate checks the field values on ListInstancesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *SendMulticastRequest) Validate() error {
+func (m *ListInstancesRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+	// no validation rules for Ref
+
+	// no validation rules for Labels
+
+	// no validation rules for Token
+
+	if v, ok := interface{}(m.GetLastSeenAfter()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return SendMulticastRequestValidationError{
-				field:  "Message",
+			return ListInstancesRequestValidationError{
+				field:  "LastSeenAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetLastSeenBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListInstancesRequestValidationError{
+				field:  "LastSeenBefore",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if _, ok := Platform_name[int32(m.GetPlatform())]; !ok {
+		return ListInstancesRequestValidationError{
+			field:  "Platform",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for AppVersion
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
 	return nil
 }
 
-// SendMulticastRequestValidationError is the validation error returned by
-// SendMulticastRequest.Validate if the designated constraints aren't met.
-type SendMulticastRequestValidationError struct {
+// ListInstancesRequestValidationError is the validation error returned by
+// ListInstancesRequest.Validate if the designated constraints aren't met.
+type ListInstancesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -438,24 +539,24 @@ type SendMulticastRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SendMulticastRequestValidationError) Field() string { return e.field }
+func (e ListInstancesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SendMulticastRequestValidationError) Reason() string { return e.reason }
+func (e ListInstancesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SendMulticastRequestValidationError) Cause() error { return e.cause }
+func (e ListInstancesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SendMulticastRequestValidationError) Key() bool { return e.key }
+func (e ListInstancesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SendMulticastRequestValidationError) ErrorName() string {
-	return "SendMulticastRequestValidationError"
+func (e ListInstancesRequestValidationError) ErrorName() string {
+	return "ListInstancesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SendMulticastRequestValidationError) Error() string {
+func (e ListInstancesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -467,14 +568,14 @@ func (e SendMulticastRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSendMulticastRequest.%s: %s%s",
+		"invalid %sListInstancesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SendMulticastRequestValidationError{}
+var _ error = ListInstancesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -482,33 +583,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SendMulticastRequestValidationError{}
+} = ListInstancesRequestValidationError{}
 
-// Validate checks the field values on Message with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *Message) Validate() error {
+// Validate checks the field values on ListUserDevicesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListUserDevicesRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for TemplateId
-
-	// no validation rules for TemplateData
-
-	// no validation rules for Data
-
-	// no validation rules for Token
+	if utf8.RuneCountInString(m.GetRef()) < 1 {
+		return ListUserDevicesRequestValidationError{
+			field:  "Ref",
+			reason: "value length must be at least 1 runes",
+		}
+	}
 
-	// no validation rules for Topic
+	// no validation rules for PageSize
 
-	// no validation rules for Condition
+	// no validation rules for PageToken
 
 	return nil
 }
 
-// MessageValidationError is the validation error returned by Message.Validate
-// if the designated constraints aren't met.
-type MessageValidationError struct {
+// ListUserDevicesRequestValidationError is the validation error returned by
+// ListUserDevicesRequest.Validate if the designated constraints aren't met.
+type ListUserDevicesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -516,22 +617,24 @@ type MessageValidationError struct {
 }
 
 // Field function returns field value.
-func (e MessageValidationError) Field() string { return e.field }
+func (e ListUserDevicesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MessageValidationError) Reason() string { return e.reason }
+func (e ListUserDevicesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MessageValidationError) Cause() error { return e.cause }
+func (e ListUserDevicesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MessageValidationError) Key() bool { return e.key }
+func (e ListUserDevicesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MessageValidationError) ErrorName() string { return "MessageValidationError" }
+func (e ListUserDevicesRequestValidationError) ErrorName() string {
+	return "ListUserDevicesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MessageValidationError) Error() string {
+func (e ListUserDevicesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -543,14 +646,14 @@ func (e MessageValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMessage.%s: %s%s",
+		"invalid %sListUserDevicesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MessageValidationError{}
+var _ error = ListUserDevicesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -558,28 +661,29 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MessageValidationError{}
+} = ListUserDevicesRequestValidationError{}
 
-// Validate checks the field values on MulticastMessage with the rules defined
+// Validate checks the field values on RemoveUserRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, an
 // error is returned.
-func (m *MulticastMessage) Validate() error {
+func (m *RemoveUserRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	// no validation rules for TemplateId
-
-	// no validation rules for TemplateData
-
-	// no validation rules for Data
+	if utf8.RuneCountInString(m.GetRef()) < 1 {
+		return RemoveUserRequestValidationError{
+			field:  "Ref",
+			reason: "value length must be at least 1 runes",
+		}
+	}
 
 	return nil
 }
 
-// MulticastMessageValidationError is the validation error returned by
-// MulticastMessage.Validate if the designated constraints aren't met.
-type MulticastMessageValidationError struct {
+// RemoveUserRequestValidationError is the validation error returned by
+// RemoveUserRequest.Validate if the designated constraints aren't met.
+type RemoveUserRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -587,22 +691,24 @@ type MulticastMessageValidationError struct {
 }
 
 // Field function returns field value.
-func (e MulticastMessageValidationError) Field() string { return e.field }
+func (e RemoveUserRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MulticastMessageValidationError) Reason() string { return e.reason }
+func (e RemoveUserRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MulticastMessageValidationError) Cause() error { return e.cause }
+func (e RemoveUserRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MulticastMessageValidationError) Key() bool { return e.key }
+func (e RemoveUserRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MulticastMessageValidationError) ErrorName() string { return "MulticastMessageValidationError" }
+func (e RemoveUserRequestValidationError) ErrorName() string {
+	return "RemoveUserRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e MulticastMessageValidationError) Error() string {
+func (e RemoveUserRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -614,14 +720,14 @@ func (e MulticastMessageValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMulticastMessage.%s: %s%s",
+		"invalid %sRemoveUserRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MulticastMessageValidationError{}
+var _ error = RemoveUserRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -629,36 +735,68 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MulticastMessageValidationError{}
+} = RemoveUserRequestValidationError{}
 
-// Validate checks the field values on ListNotificationsRequest with the rules
+// Validate checks the field values on CleanupInstancesRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
-func (m *ListNotificationsRequest) Validate() error {
+func (m *CleanupInstancesRequest) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ListNotificationsRequestValidationError{
-				field:  "Filter",
-				reason: "embedded message failed validation",
+	if d := m.GetTokenMaxAge(); d != nil {
+		dur, err := ptypes.Duration(d)
+		if err != nil {
+			return CleanupInstancesRequestValidationError{
+				field:  "TokenMaxAge",
+				reason: "value is not a valid duration",
 				cause:  err,
 			}
 		}
+
+		gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+		if dur < gte {
+			return CleanupInstancesRequestValidationError{
+				field:  "TokenMaxAge",
+				reason: "value must be greater than or equal to 0s",
+			}
+		}
+
 	}
 
-	// no validation rules for PageSize
+	if d := m.GetInstanceMaxAge(); d != nil {
+		dur, err := ptypes.Duration(d)
+		if err != nil {
+			return CleanupInstancesRequestValidationError{
+				field:  "InstanceMaxAge",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+		}
 
-	// no validation rules for PageToken
+		gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+		if dur < gte {
+			return CleanupInstancesRequestValidationError{
+				field:  "InstanceMaxAge",
+				reason: "value must be greater than or equal to 0s",
+			}
+		}
+
+	}
+
+	// no validation rules for ValidateTokens
+
+	// no validation rules for DryRun
 
 	return nil
 }
 
-// ListNotificationsRequestValidationError is the validation error returned by
-// ListNotificationsRequest.Validate if the designated constraints aren't met.
-type ListNotificationsRequestValidationError struct {
+// CleanupInstancesRequestValidationError is the validation error returned by
+// CleanupInstancesRequest.Validate if the designated constraints aren't met.
+type CleanupInstancesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -666,24 +804,24 @@ type ListNotificationsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListNotificationsRequestValidationError) Field() string { return e.field }
+func (e CleanupInstancesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListNotificationsRequestValidationError) Reason() string { return e.reason }
+func (e CleanupInstancesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListNotificationsRequestValidationError) Cause() error { return e.cause }
+func (e CleanupInstancesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListNotificationsRequestValidationError) Key() bool { return e.key }
+func (e CleanupInstancesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListNotificationsRequestValidationError) ErrorName() string {
-	return "ListNotificationsRequestValidationError"
+func (e CleanupInstancesRequestValidationError) ErrorName() string {
+	return "CleanupInstancesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListNotificationsRequestValidationError) Error() string {
+func (e CleanupInstancesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -695,14 +833,14 @@ func (e ListNotificationsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListNotificationsRequest.%s: %s%s",
+		"invalid %sCleanupInstancesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListNotificationsRequestValidationError{}
+var _ error = CleanupInstancesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -710,39 +848,2805 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListNotificationsRequestValidationError{}
+} = CleanupInstancesRequestValidationError{}
 
-// Validate checks the field values on NotificationList with the rules defined
-// in the proto definition for this message. If any rules are violated, an
-// error is returned.
-func (m *NotificationList) Validate() error {
+// Validate checks the field values on CleanupReport with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *CleanupReport) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetNotifications() {
-		_, _ = idx, item
+	// no validation rules for Scanned
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return NotificationListValidationError{
-					field:  fmt.Sprintf("Notifications[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+	// no validation rules for TokensExpired
+
+	// no validation rules for TokensInvalid
+
+	// no validation rules for InstancesRemoved
+
+	return nil
+}
+
+// CleanupReportValidationError is the validation error returned by
+// CleanupReport.Validate if the designated constraints aren't met.
+type CleanupReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CleanupReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CleanupReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CleanupReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CleanupReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CleanupReportValidationError) ErrorName() string { return "CleanupReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CleanupReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCleanupReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CleanupReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CleanupReportValidationError{}
+
+// Validate checks the field values on DeduplicateTokensRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeduplicateTokensRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for DryRun
+
+	return nil
+}
+
+// DeduplicateTokensRequestValidationError is the validation error returned by
+// DeduplicateTokensRequest.Validate if the designated constraints aren't met.
+type DeduplicateTokensRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeduplicateTokensRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeduplicateTokensRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeduplicateTokensRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeduplicateTokensRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeduplicateTokensRequestValidationError) ErrorName() string {
+	return "DeduplicateTokensRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeduplicateTokensRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeduplicateTokensRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeduplicateTokensRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeduplicateTokensRequestValidationError{}
+
+// Validate checks the field values on DeduplicateTokensReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeduplicateTokensReport) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Scanned
+
+	// no validation rules for DuplicateTokens
+
+	// no validation rules for TokensRemoved
+
+	return nil
+}
+
+// DeduplicateTokensReportValidationError is the validation error returned by
+// DeduplicateTokensReport.Validate if the designated constraints aren't met.
+type DeduplicateTokensReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeduplicateTokensReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeduplicateTokensReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeduplicateTokensReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeduplicateTokensReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeduplicateTokensReportValidationError) ErrorName() string {
+	return "DeduplicateTokensReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeduplicateTokensReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeduplicateTokensReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeduplicateTokensReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeduplicateTokensReportValidationError{}
+
+// Validate checks the field values on ImportReport with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ImportReport) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Imported
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportReportValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ImportReportValidationError is the validation error returned by
+// ImportReport.Validate if the designated constraints aren't met.
+type ImportReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportReportValidationError) ErrorName() string { return "ImportReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportReportValidationError{}
+
+// Validate checks the field values on ImportError with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *ImportError) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Row
+
+	// no validation rules for InstanceId
+
+	// no validation rules for Reason
+
+	return nil
+}
+
+// ImportErrorValidationError is the validation error returned by
+// ImportError.Validate if the designated constraints aren't met.
+type ImportErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportErrorValidationError) ErrorName() string { return "ImportErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportErrorValidationError{}
+
+// Validate checks the field values on ExportInstancesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ExportInstancesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for StartAfter
+
+	return nil
+}
+
+// ExportInstancesRequestValidationError is the validation error returned by
+// ExportInstancesRequest.Validate if the designated constraints aren't met.
+type ExportInstancesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportInstancesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportInstancesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportInstancesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportInstancesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportInstancesRequestValidationError) ErrorName() string {
+	return "ExportInstancesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportInstancesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportInstancesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportInstancesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportInstancesRequestValidationError{}
+
+// Validate checks the field values on AppInstanceList with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *AppInstanceList) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetInstances() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppInstanceListValidationError{
+					field:  fmt.Sprintf("Instances[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	return nil
+}
+
+// AppInstanceListValidationError is the validation error returned by
+// AppInstanceList.Validate if the designated constraints aren't met.
+type AppInstanceListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppInstanceListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppInstanceListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppInstanceListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppInstanceListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppInstanceListValidationError) ErrorName() string { return "AppInstanceListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppInstanceListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppInstanceList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppInstanceListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppInstanceListValidationError{}
+
+// Validate checks the field values on SendRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *SendRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetMessage() == nil {
+		return SendRequestValidationError{
+			field:  "Message",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendRequestValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// SendRequestValidationError is the validation error returned by
+// SendRequest.Validate if the designated constraints aren't met.
+type SendRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendRequestValidationError) ErrorName() string { return "SendRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendRequestValidationError{}
+
+// Validate checks the field values on SendAllRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *SendAllRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if len(m.GetMessages()) < 1 {
+		return SendAllRequestValidationError{
+			field:  "Messages",
+			reason: "value must contain at least 1 item(s)",
+		}
+	}
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SendAllRequestValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for IdempotencyKey
+
+	return nil
+}
+
+// SendAllRequestValidationError is the validation error returned by
+// SendAllRequest.Validate if the designated constraints aren't met.
+type SendAllRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendAllRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendAllRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendAllRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendAllRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendAllRequestValidationError) ErrorName() string { return "SendAllRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SendAllRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendAllRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendAllRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendAllRequestValidationError{}
+
+// Validate checks the field values on SendMulticastRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SendMulticastRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if m.GetMessage() == nil {
+		return SendMulticastRequestValidationError{
+			field:  "Message",
+			reason: "value is required",
+		}
+	}
+
+	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SendMulticastRequestValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// SendMulticastRequestValidationError is the validation error returned by
+// SendMulticastRequest.Validate if the designated constraints aren't met.
+type SendMulticastRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SendMulticastRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SendMulticastRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SendMulticastRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SendMulticastRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SendMulticastRequestValidationError) ErrorName() string {
+	return "SendMulticastRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SendMulticastRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSendMulticastRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SendMulticastRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SendMulticastRequestValidationError{}
+
+// Validate checks the field values on Message with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Message) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetTemplateId()) < 1 {
+		return MessageValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for TemplateData
+
+	// no validation rules for Data
+
+	// no validation rules for Token
+
+	// no validation rules for Topic
+
+	// no validation rules for Condition
+
+	// no validation rules for Ref
+
+	if v, ok := interface{}(m.GetSendAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageValidationError{
+				field:  "SendAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Critical
+
+	// no validation rules for Audience
+
+	// no validation rules for IdempotencyKey
+
+	return nil
+}
+
+// MessageValidationError is the validation error returned by Message.Validate
+// if the designated constraints aren't met.
+type MessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageValidationError) ErrorName() string { return "MessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageValidationError{}
+
+// Validate checks the field values on MulticastMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *MulticastMessage) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetTemplateId()) < 1 {
+		return MulticastMessageValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for TemplateData
+
+	// no validation rules for Data
+
+	// no validation rules for IdempotencyKey
+
+	// no validation rules for Audience
+
+	// no validation rules for SegmentId
+
+	if v, ok := interface{}(m.GetSendAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MulticastMessageValidationError{
+				field:  "SendAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetLocalTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MulticastMessageValidationError{
+				field:  "LocalTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Critical
+
+	return nil
+}
+
+// MulticastMessageValidationError is the validation error returned by
+// MulticastMessage.Validate if the designated constraints aren't met.
+type MulticastMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MulticastMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MulticastMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MulticastMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MulticastMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MulticastMessageValidationError) ErrorName() string { return "MulticastMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MulticastMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMulticastMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MulticastMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MulticastMessageValidationError{}
+
+// Validate checks the field values on LocalTime with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *LocalTime) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if val := m.GetHour(); val < 0 || val > 23 {
+		return LocalTimeValidationError{
+			field:  "Hour",
+			reason: "value must be inside range [0, 23]",
+		}
+	}
+
+	if val := m.GetMinute(); val < 0 || val > 59 {
+		return LocalTimeValidationError{
+			field:  "Minute",
+			reason: "value must be inside range [0, 59]",
+		}
+	}
+
+	// no validation rules for Date
+
+	// no validation rules for DefaultTimezone
+
+	return nil
+}
+
+// LocalTimeValidationError is the validation error returned by
+// LocalTime.Validate if the designated constraints aren't met.
+type LocalTimeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LocalTimeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LocalTimeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LocalTimeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LocalTimeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LocalTimeValidationError) ErrorName() string { return "LocalTimeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LocalTimeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLocalTime.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LocalTimeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LocalTimeValidationError{}
+
+// Validate checks the field values on CountAudienceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CountAudienceRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetAudience()) < 1 {
+		return CountAudienceRequestValidationError{
+			field:  "Audience",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// CountAudienceRequestValidationError is the validation error returned by
+// CountAudienceRequest.Validate if the designated constraints aren't met.
+type CountAudienceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CountAudienceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CountAudienceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CountAudienceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CountAudienceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CountAudienceRequestValidationError) ErrorName() string {
+	return "CountAudienceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CountAudienceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCountAudienceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CountAudienceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CountAudienceRequestValidationError{}
+
+// Validate checks the field values on AudienceCount with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *AudienceCount) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Instances
+
+	// no validation rules for Tokens
+
+	return nil
+}
+
+// AudienceCountValidationError is the validation error returned by
+// AudienceCount.Validate if the designated constraints aren't met.
+type AudienceCountValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AudienceCountValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AudienceCountValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AudienceCountValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AudienceCountValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AudienceCountValidationError) ErrorName() string { return "AudienceCountValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AudienceCountValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAudienceCount.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AudienceCountValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AudienceCountValidationError{}
+
+// Validate checks the field values on Segment with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Segment) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		return SegmentValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Description
+
+	if utf8.RuneCountInString(m.GetAudience()) < 1 {
+		return SegmentValidationError{
+			field:  "Audience",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SegmentValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// SegmentValidationError is the validation error returned by Segment.Validate
+// if the designated constraints aren't met.
+type SegmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SegmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SegmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SegmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SegmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SegmentValidationError) ErrorName() string { return "SegmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SegmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSegment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SegmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SegmentValidationError{}
+
+// Validate checks the field values on ListSegmentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListSegmentsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	return nil
+}
+
+// ListSegmentsRequestValidationError is the validation error returned by
+// ListSegmentsRequest.Validate if the designated constraints aren't met.
+type ListSegmentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSegmentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSegmentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSegmentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSegmentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSegmentsRequestValidationError) ErrorName() string {
+	return "ListSegmentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSegmentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSegmentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSegmentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSegmentsRequestValidationError{}
+
+// Validate checks the field values on SegmentList with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *SegmentList) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetSegments() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SegmentListValidationError{
+					field:  fmt.Sprintf("Segments[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	return nil
+}
+
+// SegmentListValidationError is the validation error returned by
+// SegmentList.Validate if the designated constraints aren't met.
+type SegmentListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SegmentListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SegmentListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SegmentListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SegmentListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SegmentListValidationError) ErrorName() string { return "SegmentListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SegmentListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSegmentList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SegmentListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SegmentListValidationError{}
+
+// Validate checks the field values on DeleteSegmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteSegmentRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return DeleteSegmentRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// DeleteSegmentRequestValidationError is the validation error returned by
+// DeleteSegmentRequest.Validate if the designated constraints aren't met.
+type DeleteSegmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteSegmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteSegmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteSegmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteSegmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteSegmentRequestValidationError) ErrorName() string {
+	return "DeleteSegmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteSegmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteSegmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteSegmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteSegmentRequestValidationError{}
+
+// Validate checks the field values on Preferences with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *Preferences) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetRef()) < 1 {
+		return PreferencesValidationError{
+			field:  "Ref",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	for key, val := range m.GetCategories() {
+		_ = val
+
+		// no validation rules for Categories[key]
+
+		if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreferencesValidationError{
+					field:  fmt.Sprintf("Categories[%v]", key),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreferencesValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// PreferencesValidationError is the validation error returned by
+// Preferences.Validate if the designated constraints aren't met.
+type PreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreferencesValidationError) ErrorName() string { return "PreferencesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreferencesValidationError{}
+
+// Validate checks the field values on CategoryPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CategoryPreferences) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if _, ok := Consent_name[int32(m.GetConsent())]; !ok {
+		return CategoryPreferencesValidationError{
+			field:  "Consent",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	for key, val := range m.GetChannels() {
+		_ = val
+
+		if !_CategoryPreferences_Channels_Pattern.MatchString(key) {
+			return CategoryPreferencesValidationError{
+				field:  fmt.Sprintf("Channels[%v]", key),
+				reason: "value does not match regex pattern \"^(android|ios|web)$\"",
+			}
+		}
+
+		if _, ok := Consent_name[int32(val)]; !ok {
+			return CategoryPreferencesValidationError{
+				field:  fmt.Sprintf("Channels[%v]", key),
+				reason: "value must be one of the defined enum values",
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// CategoryPreferencesValidationError is the validation error returned by
+// CategoryPreferences.Validate if the designated constraints aren't met.
+type CategoryPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CategoryPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CategoryPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CategoryPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CategoryPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CategoryPreferencesValidationError) ErrorName() string {
+	return "CategoryPreferencesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CategoryPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCategoryPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CategoryPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CategoryPreferencesValidationError{}
+
+var _CategoryPreferences_Channels_Pattern = regexp.MustCompile("^(android|ios|web)$")
+
+// Validate checks the field values on GetPreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetPreferencesRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetRef()) < 1 {
+		return GetPreferencesRequestValidationError{
+			field:  "Ref",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// GetPreferencesRequestValidationError is the validation error returned by
+// GetPreferencesRequest.Validate if the designated constraints aren't met.
+type GetPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPreferencesRequestValidationError) ErrorName() string {
+	return "GetPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPreferencesRequestValidationError{}
+
+// Validate checks the field values on ScheduledNotification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ScheduledNotification) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Method
+
+	// no validation rules for Payload
+
+	if v, ok := interface{}(m.GetSendAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledNotificationValidationError{
+				field:  "SendAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for State
+
+	// no validation rules for Attempts
+
+	// no validation rules for LastError
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledNotificationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetDueAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledNotificationValidationError{
+				field:  "DueAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// ScheduledNotificationValidationError is the validation error returned by
+// ScheduledNotification.Validate if the designated constraints aren't met.
+type ScheduledNotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduledNotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduledNotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduledNotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduledNotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduledNotificationValidationError) ErrorName() string {
+	return "ScheduledNotificationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduledNotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduledNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduledNotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduledNotificationValidationError{}
+
+// Validate checks the field values on ListScheduledRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListScheduledRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if _, ok := ScheduledNotification_State_name[int32(m.GetState())]; !ok {
+		return ListScheduledRequestValidationError{
+			field:  "State",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	return nil
+}
+
+// ListScheduledRequestValidationError is the validation error returned by
+// ListScheduledRequest.Validate if the designated constraints aren't met.
+type ListScheduledRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScheduledRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScheduledRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScheduledRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScheduledRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScheduledRequestValidationError) ErrorName() string {
+	return "ListScheduledRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScheduledRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScheduledRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScheduledRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScheduledRequestValidationError{}
+
+// Validate checks the field values on ScheduledNotificationList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ScheduledNotificationList) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ScheduledNotificationListValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	return nil
+}
+
+// ScheduledNotificationListValidationError is the validation error returned by
+// ScheduledNotificationList.Validate if the designated constraints aren't met.
+type ScheduledNotificationListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduledNotificationListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduledNotificationListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduledNotificationListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduledNotificationListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduledNotificationListValidationError) ErrorName() string {
+	return "ScheduledNotificationListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduledNotificationListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduledNotificationList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduledNotificationListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduledNotificationListValidationError{}
+
+// Validate checks the field values on CancelScheduledRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CancelScheduledRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return CancelScheduledRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// CancelScheduledRequestValidationError is the validation error returned by
+// CancelScheduledRequest.Validate if the designated constraints aren't met.
+type CancelScheduledRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelScheduledRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelScheduledRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelScheduledRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelScheduledRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelScheduledRequestValidationError) ErrorName() string {
+	return "CancelScheduledRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelScheduledRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelScheduledRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelScheduledRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelScheduledRequestValidationError{}
+
+// Validate checks the field values on ListNotificationsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListNotificationsRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// skipping validation for filter
+
+	if _, ok := Notification_State_name[int32(m.GetState())]; !ok {
+		return ListNotificationsRequestValidationError{
+			field:  "State",
+			reason: "value must be one of the defined enum values",
+		}
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	return nil
+}
+
+// ListNotificationsRequestValidationError is the validation error returned by
+// ListNotificationsRequest.Validate if the designated constraints aren't met.
+type ListNotificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListNotificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListNotificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListNotificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListNotificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListNotificationsRequestValidationError) ErrorName() string {
+	return "ListNotificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListNotificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListNotificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListNotificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListNotificationsRequestValidationError{}
+
+// Validate checks the field values on NotificationList with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *NotificationList) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationListValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	return nil
+}
+
+// NotificationListValidationError is the validation error returned by
+// NotificationList.Validate if the designated constraints aren't met.
+type NotificationListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationListValidationError) ErrorName() string { return "NotificationListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationListValidationError{}
+
+// Validate checks the field values on Notification with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *Notification) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationValidationError{
+				field:  "Instance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Data
+
+	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationValidationError{
+				field:  "Message",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Ref
+
+	// no validation rules for Topic
+
+	// no validation rules for Condition
+
+	// no validation rules for TemplateId
+
+	// no validation rules for Category
+
+	// no validation rules for State
+
+	// no validation rules for Reason
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// NotificationValidationError is the validation error returned by
+// Notification.Validate if the designated constraints aren't met.
+type NotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationValidationError) ErrorName() string { return "NotificationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationValidationError{}
+
+// Validate checks the field values on DeadLetter with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *DeadLetter) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Id
+
+	// no validation rules for Method
+
+	// no validation rules for Payload
+
+	// no validation rules for Reason
+
+	// no validation rules for Subscription
+
+	if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeadLetterValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Tenant
+
+	return nil
+}
+
+// DeadLetterValidationError is the validation error returned by
+// DeadLetter.Validate if the designated constraints aren't met.
+type DeadLetterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterValidationError) ErrorName() string { return "DeadLetterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeadLetterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterValidationError{}
+
+// Validate checks the field values on ListDeadLettersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListDeadLettersRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for PageSize
+
+	// no validation rules for PageToken
+
+	return nil
+}
+
+// ListDeadLettersRequestValidationError is the validation error returned by
+// ListDeadLettersRequest.Validate if the designated constraints aren't met.
+type ListDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeadLettersRequestValidationError) ErrorName() string {
+	return "ListDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeadLettersRequestValidationError{}
+
+// Validate checks the field values on DeadLetterList with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *DeadLetterList) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetDeadLetters() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeadLetterListValidationError{
+					field:  fmt.Sprintf("DeadLetters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	return nil
+}
+
+// DeadLetterListValidationError is the validation error returned by
+// DeadLetterList.Validate if the designated constraints aren't met.
+type DeadLetterListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterListValidationError) ErrorName() string { return "DeadLetterListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeadLetterListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetterList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterListValidationError{}
+
+// Validate checks the field values on ReplayDeadLetterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReplayDeadLetterRequest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		return ReplayDeadLetterRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	return nil
+}
+
+// ReplayDeadLetterRequestValidationError is the validation error returned by
+// ReplayDeadLetterRequest.Validate if the designated constraints aren't met.
+type ReplayDeadLetterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayDeadLetterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayDeadLetterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayDeadLetterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayDeadLetterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayDeadLetterRequestValidationError) ErrorName() string {
+	return "ReplayDeadLetterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayDeadLetterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayDeadLetterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayDeadLetterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayDeadLetterRequestValidationError{}
+
+// Validate checks the field values on NotificationConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *NotificationConfig) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationConfigValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
 		}
 
 	}
 
-	// no validation rules for NextPageToken
+	if v, ok := interface{}(m.GetQuietHours()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationConfigValidationError{
+				field:  "QuietHours",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for key, val := range m.GetCategoryQuietHours() {
+		_ = val
+
+		// no validation rules for CategoryQuietHours[key]
+
+		if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationConfigValidationError{
+					field:  fmt.Sprintf("CategoryQuietHours[%v]", key),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for key, val := range m.GetCategoryFrequencyCaps() {
+		_ = val
+
+		// no validation rules for CategoryFrequencyCaps[key]
+
+		if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NotificationConfigValidationError{
+					field:  fmt.Sprintf("CategoryFrequencyCaps[%v]", key),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	return nil
 }
 
-// NotificationListValidationError is the validation error returned by
-// NotificationList.Validate if the designated constraints aren't met.
-type NotificationListValidationError struct {
+// NotificationConfigValidationError is the validation error returned by
+// NotificationConfig.Validate if the designated constraints aren't met.
+type NotificationConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -750,22 +3654,24 @@ type NotificationListValidationError struct {
 }
 
 // Field function returns field value.
-func (e NotificationListValidationError) Field() string { return e.field }
+func (e NotificationConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NotificationListValidationError) Reason() string { return e.reason }
+func (e NotificationConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NotificationListValidationError) Cause() error { return e.cause }
+func (e NotificationConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NotificationListValidationError) Key() bool { return e.key }
+func (e NotificationConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NotificationListValidationError) ErrorName() string { return "NotificationListValidationError" }
+func (e NotificationConfigValidationError) ErrorName() string {
+	return "NotificationConfigValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e NotificationListValidationError) Error() string {
+func (e NotificationConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -777,14 +3683,14 @@ func (e NotificationListValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNotificationList.%s: %s%s",
+		"invalid %sNotificationConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NotificationListValidationError{}
+var _ error = NotificationConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -792,44 +3698,43 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NotificationListValidationError{}
+} = NotificationConfigValidationError{}
 
-// Validate checks the field values on Notification with the rules defined in
+// Validate checks the field values on FrequencyCap with the rules defined in
 // the proto definition for this message. If any rules are violated, an error
 // is returned.
-func (m *Notification) Validate() error {
+func (m *FrequencyCap) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return NotificationValidationError{
-				field:  "Instance",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+	if m.GetMax() <= 0 {
+		return FrequencyCapValidationError{
+			field:  "Max",
+			reason: "value must be greater than 0",
 		}
 	}
 
-	// no validation rules for Data
+	if utf8.RuneCountInString(m.GetPeriod()) < 2 {
+		return FrequencyCapValidationError{
+			field:  "Period",
+			reason: "value length must be at least 2 runes",
+		}
+	}
 
-	if v, ok := interface{}(m.GetMessage()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return NotificationValidationError{
-				field:  "Message",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+	if _, ok := FrequencyCap_Action_name[int32(m.GetAction())]; !ok {
+		return FrequencyCapValidationError{
+			field:  "Action",
+			reason: "value must be one of the defined enum values",
 		}
 	}
 
 	return nil
 }
 
-// NotificationValidationError is the validation error returned by
-// Notification.Validate if the designated constraints aren't met.
-type NotificationValidationError struct {
+// FrequencyCapValidationError is the validation error returned by
+// FrequencyCap.Validate if the designated constraints aren't met.
+type FrequencyCapValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -837,22 +3742,22 @@ type NotificationValidationError struct {
 }
 
 // Field function returns field value.
-func (e NotificationValidationError) Field() string { return e.field }
+func (e FrequencyCapValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NotificationValidationError) Reason() string { return e.reason }
+func (e FrequencyCapValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NotificationValidationError) Cause() error { return e.cause }
+func (e FrequencyCapValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NotificationValidationError) Key() bool { return e.key }
+func (e FrequencyCapValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NotificationValidationError) ErrorName() string { return "NotificationValidationError" }
+func (e FrequencyCapValidationError) ErrorName() string { return "FrequencyCapValidationError" }
 
 // Error satisfies the builtin error interface
-func (e NotificationValidationError) Error() string {
+func (e FrequencyCapValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -864,14 +3769,14 @@ func (e NotificationValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNotification.%s: %s%s",
+		"invalid %sFrequencyCap.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NotificationValidationError{}
+var _ error = FrequencyCapValidationError{}
 
 var _ interface {
 	Field() string
@@ -879,37 +3784,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NotificationValidationError{}
+} = FrequencyCapValidationError{}
 
-// Validate checks the field values on NotificationConfig with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, an error is returned.
-func (m *NotificationConfig) Validate() error {
+// Validate checks the field values on QuietHours with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *QuietHours) Validate() error {
 	if m == nil {
 		return nil
 	}
 
-	for idx, item := range m.GetMessages() {
-		_, _ = idx, item
+	if !_QuietHours_Start_Pattern.MatchString(m.GetStart()) {
+		return QuietHoursValidationError{
+			field:  "Start",
+			reason: "value does not match regex pattern \"^([01][0-9]|2[0-3]):[0-5][0-9]$\"",
+		}
+	}
 
-		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return NotificationConfigValidationError{
-					field:  fmt.Sprintf("Messages[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if !_QuietHours_End_Pattern.MatchString(m.GetEnd()) {
+		return QuietHoursValidationError{
+			field:  "End",
+			reason: "value does not match regex pattern \"^([01][0-9]|2[0-3]):[0-5][0-9]$\"",
 		}
+	}
 
+	if _, ok := QuietHours_Action_name[int32(m.GetAction())]; !ok {
+		return QuietHoursValidationError{
+			field:  "Action",
+			reason: "value must be one of the defined enum values",
+		}
 	}
 
+	// no validation rules for Timezone
+
 	return nil
 }
 
-// NotificationConfigValidationError is the validation error returned by
-// NotificationConfig.Validate if the designated constraints aren't met.
-type NotificationConfigValidationError struct {
+// QuietHoursValidationError is the validation error returned by
+// QuietHours.Validate if the designated constraints aren't met.
+type QuietHoursValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -917,24 +3829,22 @@ type NotificationConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e NotificationConfigValidationError) Field() string { return e.field }
+func (e QuietHoursValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e NotificationConfigValidationError) Reason() string { return e.reason }
+func (e QuietHoursValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e NotificationConfigValidationError) Cause() error { return e.cause }
+func (e QuietHoursValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e NotificationConfigValidationError) Key() bool { return e.key }
+func (e QuietHoursValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e NotificationConfigValidationError) ErrorName() string {
-	return "NotificationConfigValidationError"
-}
+func (e QuietHoursValidationError) ErrorName() string { return "QuietHoursValidationError" }
 
 // Error satisfies the builtin error interface
-func (e NotificationConfigValidationError) Error() string {
+func (e QuietHoursValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -946,14 +3856,14 @@ func (e NotificationConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sNotificationConfig.%s: %s%s",
+		"invalid %sQuietHours.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = NotificationConfigValidationError{}
+var _ error = QuietHoursValidationError{}
 
 var _ interface {
 	Field() string
@@ -961,7 +3871,11 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = NotificationConfigValidationError{}
+} = QuietHoursValidationError{}
+
+var _QuietHours_Start_Pattern = regexp.MustCompile("^([01][0-9]|2[0-3]):[0-5][0-9]$")
+
+var _QuietHours_End_Pattern = regexp.MustCompile("^([01][0-9]|2[0-3]):[0-5][0-9]$")
 
 // Validate checks the field values on MessageTemplate with the rules defined
 // in the proto definition for this message. If any rules are violated, an
@@ -990,12 +3904,135 @@ func (m *MessageTemplate) Validate() error {
 
 	}
 
+	if v, ok := interface{}(m.GetFcmMessage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageTemplateValidationError{
+				field:  "FcmMessage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Category
+
+	if v, ok := interface{}(m.GetFrequencyCap()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageTemplateValidationError{
+				field:  "FrequencyCap",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CollapseGroup
+
+	if v, ok := interface{}(m.GetDigest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageTemplateValidationError{
+				field:  "Digest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	return nil
+}
+
+// MessageTemplateValidationError is the validation error returned by
+// MessageTemplate.Validate if the designated constraints aren't met.
+type MessageTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MessageTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MessageTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MessageTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MessageTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MessageTemplateValidationError) ErrorName() string { return "MessageTemplateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MessageTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMessageTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MessageTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MessageTemplateValidationError{}
+
+// Validate checks the field values on Digest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Digest) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	if utf8.RuneCountInString(m.GetWindow()) < 2 {
+		return DigestValidationError{
+			field:  "Window",
+			reason: "value length must be at least 2 runes",
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetTemplateId()) < 1 {
+		return DigestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 runes",
+		}
+	}
+
+	// no validation rules for Item
+
+	// no validation rules for Separator
+
+	if val := m.GetMaxItems(); val < 0 || val > 100 {
+		return DigestValidationError{
+			field:  "MaxItems",
+			reason: "value must be inside range [0, 100]",
+		}
+	}
+
 	return nil
 }
 
-// MessageTemplateValidationError is the validation error returned by
-// MessageTemplate.Validate if the designated constraints aren't met.
-type MessageTemplateValidationError struct {
+// DigestValidationError is the validation error returned by Digest.Validate if
+// the designated constraints aren't met.
+type DigestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1003,22 +4040,22 @@ type MessageTemplateValidationError struct {
 }
 
 // Field function returns field value.
-func (e MessageTemplateValidationError) Field() string { return e.field }
+func (e DigestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MessageTemplateValidationError) Reason() string { return e.reason }
+func (e DigestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MessageTemplateValidationError) Cause() error { return e.cause }
+func (e DigestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MessageTemplateValidationError) Key() bool { return e.key }
+func (e DigestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MessageTemplateValidationError) ErrorName() string { return "MessageTemplateValidationError" }
+func (e DigestValidationError) ErrorName() string { return "DigestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MessageTemplateValidationError) Error() string {
+func (e DigestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1030,14 +4067,14 @@ func (e MessageTemplateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMessageTemplate.%s: %s%s",
+		"invalid %sDigest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MessageTemplateValidationError{}
+var _ error = DigestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1045,7 +4082,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MessageTemplateValidationError{}
+} = DigestValidationError{}
 
 // Validate checks the field values on FCMMessage with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
@@ -1507,6 +4544,30 @@ func (m *FCMWebpush) Validate() error {
 		return nil
 	}
 
+	// no validation rules for Headers
+
+	// no validation rules for Data
+
+	if v, ok := interface{}(m.GetNotification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FCMWebpushValidationError{
+				field:  "Notification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if v, ok := interface{}(m.GetFcmOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FCMWebpushValidationError{
+				field:  "FcmOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -1564,19 +4625,296 @@ var _ interface {
 	ErrorName() string
 } = FCMWebpushValidationError{}
 
-// Validate checks the field values on FCMAPNS with the rules defined in the
-// proto definition for this message. If any rules are violated, an error is returned.
-func (m *FCMAPNS) Validate() error {
+// Validate checks the field values on FCMWebpushNotification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FCMWebpushNotification) Validate() error {
 	if m == nil {
 		return nil
 	}
 
+	for idx, item := range m.GetActions() {
+		_, _ = idx, item
+
+		if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FCMWebpushNotificationValidationError{
+					field:  fmt.Sprintf("Actions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Title
+
+	// no validation rules for Body
+
+	// no validation rules for Icon
+
+	// no validation rules for Badge
+
+	// no validation rules for Direction
+
+	if v, ok := interface{}(m.GetData()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FCMWebpushNotificationValidationError{
+				field:  "Data",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Image
+
+	// no validation rules for Language
+
+	// no validation rules for Renotify
+
+	// no validation rules for RequireInteraction
+
+	// no validation rules for Silent
+
+	// no validation rules for Tag
+
+	// no validation rules for TimestampMillis
+
+	for key, val := range m.GetCustomData() {
+		_ = val
+
+		// no validation rules for CustomData[key]
+
+		if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FCMWebpushNotificationValidationError{
+					field:  fmt.Sprintf("CustomData[%v]", key),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// FCMAPNSValidationError is the validation error returned by FCMAPNS.Validate
-// if the designated constraints aren't met.
-type FCMAPNSValidationError struct {
+// FCMWebpushNotificationValidationError is the validation error returned by
+// FCMWebpushNotification.Validate if the designated constraints aren't met.
+type FCMWebpushNotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FCMWebpushNotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FCMWebpushNotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FCMWebpushNotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FCMWebpushNotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FCMWebpushNotificationValidationError) ErrorName() string {
+	return "FCMWebpushNotificationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FCMWebpushNotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFCMWebpushNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FCMWebpushNotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FCMWebpushNotificationValidationError{}
+
+// Validate checks the field values on FCMWebpushNotificationAction with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *FCMWebpushNotificationAction) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Action
+
+	// no validation rules for Title
+
+	// no validation rules for Icon
+
+	return nil
+}
+
+// FCMWebpushNotificationActionValidationError is the validation error returned
+// by FCMWebpushNotificationAction.Validate if the designated constraints
+// aren't met.
+type FCMWebpushNotificationActionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FCMWebpushNotificationActionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FCMWebpushNotificationActionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FCMWebpushNotificationActionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FCMWebpushNotificationActionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FCMWebpushNotificationActionValidationError) ErrorName() string {
+	return "FCMWebpushNotificationActionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FCMWebpushNotificationActionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFCMWebpushNotificationAction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FCMWebpushNotificationActionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FCMWebpushNotificationActionValidationError{}
+
+// Validate checks the field values on FCMWebpushOptions with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *FCMWebpushOptions) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	// no validation rules for Link
+
+	return nil
+}
+
+// FCMWebpushOptionsValidationError is the validation error returned by
+// FCMWebpushOptions.Validate if the designated constraints aren't met.
+type FCMWebpushOptionsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FCMWebpushOptionsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FCMWebpushOptionsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FCMWebpushOptionsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FCMWebpushOptionsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FCMWebpushOptionsValidationError) ErrorName() string {
+	return "FCMWebpushOptionsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FCMWebpushOptionsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFCMWebpushOptions.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FCMWebpushOptionsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FCMWebpushOptionsValidationError{}
+
+// Validate checks the field values on FCMAPNSConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *FCMAPNSConfig) Validate() error {
+	if m == nil {
+		return nil
+	}
+
+	return nil
+}
+
+// FCMAPNSConfigValidationError is the validation error returned by
+// FCMAPNSConfig.Validate if the designated constraints aren't met.
+type FCMAPNSConfigValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1584,22 +4922,22 @@ type FCMAPNSValidationError struct {
 }
 
 // Field function returns field value.
-func (e FCMAPNSValidationError) Field() string { return e.field }
+func (e FCMAPNSConfigValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e FCMAPNSValidationError) Reason() string { return e.reason }
+func (e FCMAPNSConfigValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e FCMAPNSValidationError) Cause() error { return e.cause }
+func (e FCMAPNSConfigValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e FCMAPNSValidationError) Key() bool { return e.key }
+func (e FCMAPNSConfigValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e FCMAPNSValidationError) ErrorName() string { return "FCMAPNSValidationError" }
+func (e FCMAPNSConfigValidationError) ErrorName() string { return "FCMAPNSConfigValidationError" }
 
 // Error satisfies the builtin error interface
-func (e FCMAPNSValidationError) Error() string {
+func (e FCMAPNSConfigValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1611,14 +4949,14 @@ func (e FCMAPNSValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sFCMAPNS.%s: %s%s",
+		"invalid %sFCMAPNSConfig.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = FCMAPNSValidationError{}
+var _ error = FCMAPNSConfigValidationError{}
 
 var _ interface {
 	Field() string
@@ -1626,7 +4964,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = FCMAPNSValidationError{}
+} = FCMAPNSConfigValidationError{}
 
 // Validate checks the field values on FCMOptions with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
@@ -1635,6 +4973,8 @@ func (m *FCMOptions) Validate() error {
 		return nil
 	}
 
+	// no validation rules for AnalyticsLabel
+
 	return nil
 }
 
@@ -21,7 +21,11 @@ type NotificationServiceClient interface {
 	// PutInstance either adds or patches a unique instance_id within the system with the
 	// provided token, ref, and labels.
 	// In case of patch, only fields present in the request will be rewritten.
-	// Labels are rewritten if present - send the full map in case of patching.
+	// Labels are rewritten if present - send the full map in case of patching,
+	// or use UpdateLabels to change individual labels.
+	// If the update_mask is set, exactly the masked fields are written, including empty values.
+	// A token held by another instance is either transferred from it or rejected, based on
+	// the configuration of the server
 	PutInstance(ctx context.Context, in *AppInstance, opts ...grpc.CallOption) (*empty.Empty, error)
 	// RemoveToken removes the token from an existing instance in the system.
 	// This disables all notifications sent to the user and will result in warnings in logs.
@@ -32,6 +36,48 @@ type NotificationServiceClient interface {
 	// from your system (e.g. during account deletion)
 	// see https://firebase.google.com/docs/reference/android/com/google/firebase/iid/FirebaseInstanceId#deleteInstanceId()
 	RemoveInstance(ctx context.Context, in *RemoveInstanceRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// UpdateLabels changes only the provided labels of an existing instance, so concurrent
+	// updates of different labels don't overwrite each other. Labels in set are added
+	// or overwritten, labels in remove are removed. If replace is true, all labels
+	// are replaced by the set labels
+	UpdateLabels(ctx context.Context, in *UpdateLabelsRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// DeduplicateTokens removes tokens held by more than one instance from all but the most
+	// recently seen instance. PutInstance prevents new duplicates, this repairs the existing ones
+	DeduplicateTokens(ctx context.Context, in *DeduplicateTokensRequest, opts ...grpc.CallOption) (*DeduplicateTokensReport, error)
+	// ImportInstances writes the streamed instances in batches, replacing existing instances
+	// with the same instance_id. Invalid instances are reported and skipped.
+	// Tokens are not checked for uniqueness, use DeduplicateTokens after the import
+	ImportInstances(ctx context.Context, opts ...grpc.CallOption) (NotificationService_ImportInstancesClient, error)
+	// ExportInstances streams all instances ordered by their instance_id
+	ExportInstances(ctx context.Context, in *ExportInstancesRequest, opts ...grpc.CallOption) (NotificationService_ExportInstancesClient, error)
+	// GetInstance returns the instance registered under the instance_id
+	GetInstance(ctx context.Context, in *GetInstanceRequest, opts ...grpc.CallOption) (*AppInstance, error)
+	// ListInstances returns instances matching all of the set filters with a paging token.
+	// Instances are ordered by their instance_id, or by last_seen_at in a descending order
+	// if the last seen range is set, or by their token if only instances with a token are
+	// listed. Pages may be shorter than the page_size while the next_page_token is set.
+	// Combining ref or labels with the last seen range or the token presence requires
+	// a composite Firestore index
+	ListInstances(ctx context.Context, in *ListInstancesRequest, opts ...grpc.CallOption) (*AppInstanceList, error)
+	// ListUserDevices returns instances of the ref (e.g. the user ID) with a paging token,
+	// most recently seen first
+	ListUserDevices(ctx context.Context, in *ListUserDevicesRequest, opts ...grpc.CallOption) (*AppInstanceList, error)
+	// RemoveUser removes all instances, the preferences, the notification history and the pending
+	// digests of the ref from the system, and the ref and its tokens from the scheduled
+	// notifications and dead letters. Use this during the account deletion
+	RemoveUser(ctx context.Context, in *RemoveUserRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// GetPreferences returns the notification preferences of the ref. Refs without
+	// preferences consent to all categories
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*Preferences, error)
+	// UpdatePreferences merges the categories, and the channels of each category, into
+	// the preferences of the ref and returns the updated preferences. Messages of the
+	// categories the ref opted out of are not sent to its instances
+	UpdatePreferences(ctx context.Context, in *Preferences, opts ...grpc.CallOption) (*Preferences, error)
+	// CleanupInstances removes tokens of instances not seen for the token_max_age and
+	// instances not seen for the instance_max_age. Remaining tokens can be validated
+	// by a dry run send, removing the tokens rejected by FCM.
+	// This endpoint is meant to be called periodically, e.g. by Cloud Scheduler
+	CleanupInstances(ctx context.Context, in *CleanupInstancesRequest, opts ...grpc.CallOption) (*CleanupReport, error)
 	// Send sends a single notification with its data either to a token, topic, or a condition (e.g. more topics)
 	// see https://pkg.go.dev/firebase.google.com/go/messaging#Client.Send
 	// This is a Pub/Sub optimized endpoint
@@ -44,8 +90,32 @@ type NotificationServiceClient interface {
 	// see https://pkg.go.dev/firebase.google.com/go/messaging#Client.SendMulticast
 	// This is a Pub/Sub optimized endpoint
 	SendMulticast(ctx context.Context, in *SendMulticastRequest, opts ...grpc.CallOption) (*empty.Empty, error)
-	// ListNotifications returns list of notifications with a paging token
+	// CountAudience returns the number of instances and tokens matching the audience
+	// expression, so the reach of a message can be previewed before sending it
+	CountAudience(ctx context.Context, in *CountAudienceRequest, opts ...grpc.CallOption) (*AudienceCount, error)
+	// CreateSegment stores a named audience that can be targeted by multicast messages.
+	// The id is generated if not set
+	CreateSegment(ctx context.Context, in *Segment, opts ...grpc.CallOption) (*Segment, error)
+	// ListSegments returns the segments ordered by their id with a paging token
+	ListSegments(ctx context.Context, in *ListSegmentsRequest, opts ...grpc.CallOption) (*SegmentList, error)
+	// DeleteSegment removes the segment
+	DeleteSegment(ctx context.Context, in *DeleteSegmentRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListScheduled returns the scheduled notifications ordered by their send_at time
+	// with a paging token
+	ListScheduled(ctx context.Context, in *ListScheduledRequest, opts ...grpc.CallOption) (*ScheduledNotificationList, error)
+	// CancelScheduled cancels a pending scheduled notification
+	CancelScheduled(ctx context.Context, in *CancelScheduledRequest, opts ...grpc.CallOption) (*empty.Empty, error)
+	// ListNotifications returns the history of the notifications, including the ones
+	// suppressed by the frequency caps and the failed ones, in a descending list with
+	// a paging token
 	ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*NotificationList, error)
+	// ListDeadLetters returns Pub/Sub delivered requests that failed permanently (e.g. validation
+	// or an unknown template) in a descending list with a paging token.
+	// These messages were acknowledged so Pub/Sub doesn't retry them forever
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*DeadLetterList, error)
+	// ReplayDeadLetter dispatches the original payload of the dead letter to its method again.
+	// The dead letter is removed if the replay succeeds
+	ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type notificationServiceClient struct {
@@ -83,6 +153,153 @@ func (c *notificationServiceClient) RemoveInstance(ctx context.Context, in *Remo
 	return out, nil
 }
 
+func (c *notificationServiceClient) UpdateLabels(ctx context.Context, in *UpdateLabelsRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/UpdateLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeduplicateTokens(ctx context.Context, in *DeduplicateTokensRequest, opts ...grpc.CallOption) (*DeduplicateTokensReport, error) {
+	out := new(DeduplicateTokensReport)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/DeduplicateTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ImportInstances(ctx context.Context, opts ...grpc.CallOption) (NotificationService_ImportInstancesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NotificationService_serviceDesc.Streams[0], "/fcmcompanion.v1.NotificationService/ImportInstances", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notificationServiceImportInstancesClient{stream}
+	return x, nil
+}
+
+type NotificationService_ImportInstancesClient interface {
+	Send(*AppInstance) error
+	CloseAndRecv() (*ImportReport, error)
+	grpc.ClientStream
+}
+
+type notificationServiceImportInstancesClient struct {
+	grpc.ClientStream
+}
+
+func (x *notificationServiceImportInstancesClient) Send(m *AppInstance) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *notificationServiceImportInstancesClient) CloseAndRecv() (*ImportReport, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportReport)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *notificationServiceClient) ExportInstances(ctx context.Context, in *ExportInstancesRequest, opts ...grpc.CallOption) (NotificationService_ExportInstancesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_NotificationService_serviceDesc.Streams[1], "/fcmcompanion.v1.NotificationService/ExportInstances", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notificationServiceExportInstancesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type NotificationService_ExportInstancesClient interface {
+	Recv() (*AppInstance, error)
+	grpc.ClientStream
+}
+
+type notificationServiceExportInstancesClient struct {
+	grpc.ClientStream
+}
+
+func (x *notificationServiceExportInstancesClient) Recv() (*AppInstance, error) {
+	m := new(AppInstance)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *notificationServiceClient) GetInstance(ctx context.Context, in *GetInstanceRequest, opts ...grpc.CallOption) (*AppInstance, error) {
+	out := new(AppInstance)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/GetInstance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListInstances(ctx context.Context, in *ListInstancesRequest, opts ...grpc.CallOption) (*AppInstanceList, error) {
+	out := new(AppInstanceList)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/ListInstances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListUserDevices(ctx context.Context, in *ListUserDevicesRequest, opts ...grpc.CallOption) (*AppInstanceList, error) {
+	out := new(AppInstanceList)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/ListUserDevices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) RemoveUser(ctx context.Context, in *RemoveUserRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/RemoveUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*Preferences, error) {
+	out := new(Preferences)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/GetPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) UpdatePreferences(ctx context.Context, in *Preferences, opts ...grpc.CallOption) (*Preferences, error) {
+	out := new(Preferences)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/UpdatePreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CleanupInstances(ctx context.Context, in *CleanupInstancesRequest, opts ...grpc.CallOption) (*CleanupReport, error) {
+	out := new(CleanupReport)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/CleanupInstances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *notificationServiceClient) Send(ctx context.Context, in *SendRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
 	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/Send", in, out, opts...)
@@ -110,6 +327,60 @@ func (c *notificationServiceClient) SendMulticast(ctx context.Context, in *SendM
 	return out, nil
 }
 
+func (c *notificationServiceClient) CountAudience(ctx context.Context, in *CountAudienceRequest, opts ...grpc.CallOption) (*AudienceCount, error) {
+	out := new(AudienceCount)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/CountAudience", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CreateSegment(ctx context.Context, in *Segment, opts ...grpc.CallOption) (*Segment, error) {
+	out := new(Segment)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/CreateSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListSegments(ctx context.Context, in *ListSegmentsRequest, opts ...grpc.CallOption) (*SegmentList, error) {
+	out := new(SegmentList)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/ListSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) DeleteSegment(ctx context.Context, in *DeleteSegmentRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/DeleteSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ListScheduled(ctx context.Context, in *ListScheduledRequest, opts ...grpc.CallOption) (*ScheduledNotificationList, error) {
+	out := new(ScheduledNotificationList)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/ListScheduled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) CancelScheduled(ctx context.Context, in *CancelScheduledRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/CancelScheduled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *notificationServiceClient) ListNotifications(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*NotificationList, error) {
 	out := new(NotificationList)
 	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/ListNotifications", in, out, opts...)
@@ -119,6 +390,24 @@ func (c *notificationServiceClient) ListNotifications(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *notificationServiceClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*DeadLetterList, error) {
+	out := new(DeadLetterList)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/ListDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) ReplayDeadLetter(ctx context.Context, in *ReplayDeadLetterRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/fcmcompanion.v1.NotificationService/ReplayDeadLetter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 // All implementations must embed UnimplementedNotificationServiceServer
 // for forward compatibility
@@ -126,7 +415,11 @@ type NotificationServiceServer interface {
 	// PutInstance either adds or patches a unique instance_id within the system with the
 	// provided token, ref, and labels.
 	// In case of patch, only fields present in the request will be rewritten.
-	// Labels are rewritten if present - send the full map in case of patching.
+	// Labels are rewritten if present - send the full map in case of patching,
+	// or use UpdateLabels to change individual labels.
+	// If the update_mask is set, exactly the masked fields are written, including empty values.
+	// A token held by another instance is either transferred from it or rejected, based on
+	// the configuration of the server
 	PutInstance(context.Context, *AppInstance) (*empty.Empty, error)
 	// RemoveToken removes the token from an existing instance in the system.
 	// This disables all notifications sent to the user and will result in warnings in logs.
@@ -137,6 +430,48 @@ type NotificationServiceServer interface {
 	// from your system (e.g. during account deletion)
 	// see https://firebase.google.com/docs/reference/android/com/google/firebase/iid/FirebaseInstanceId#deleteInstanceId()
 	RemoveInstance(context.Context, *RemoveInstanceRequest) (*empty.Empty, error)
+	// UpdateLabels changes only the provided labels of an existing instance, so concurrent
+	// updates of different labels don't overwrite each other. Labels in set are added
+	// or overwritten, labels in remove are removed. If replace is true, all labels
+	// are replaced by the set labels
+	UpdateLabels(context.Context, *UpdateLabelsRequest) (*empty.Empty, error)
+	// DeduplicateTokens removes tokens held by more than one instance from all but the most
+	// recently seen instance. PutInstance prevents new duplicates, this repairs the existing ones
+	DeduplicateTokens(context.Context, *DeduplicateTokensRequest) (*DeduplicateTokensReport, error)
+	// ImportInstances writes the streamed instances in batches, replacing existing instances
+	// with the same instance_id. Invalid instances are reported and skipped.
+	// Tokens are not checked for uniqueness, use DeduplicateTokens after the import
+	ImportInstances(NotificationService_ImportInstancesServer) error
+	// ExportInstances streams all instances ordered by their instance_id
+	ExportInstances(*ExportInstancesRequest, NotificationService_ExportInstancesServer) error
+	// GetInstance returns the instance registered under the instance_id
+	GetInstance(context.Context, *GetInstanceRequest) (*AppInstance, error)
+	// ListInstances returns instances matching all of the set filters with a paging token.
+	// Instances are ordered by their instance_id, or by last_seen_at in a descending order
+	// if the last seen range is set, or by their token if only instances with a token are
+	// listed. Pages may be shorter than the page_size while the next_page_token is set.
+	// Combining ref or labels with the last seen range or the token presence requires
+	// a composite Firestore index
+	ListInstances(context.Context, *ListInstancesRequest) (*AppInstanceList, error)
+	// ListUserDevices returns instances of the ref (e.g. the user ID) with a paging token,
+	// most recently seen first
+	ListUserDevices(context.Context, *ListUserDevicesRequest) (*AppInstanceList, error)
+	// RemoveUser removes all instances, the preferences, the notification history and the pending
+	// digests of the ref from the system, and the ref and its tokens from the scheduled
+	// notifications and dead letters. Use this during the account deletion
+	RemoveUser(context.Context, *RemoveUserRequest) (*empty.Empty, error)
+	// GetPreferences returns the notification preferences of the ref. Refs without
+	// preferences consent to all categories
+	GetPreferences(context.Context, *GetPreferencesRequest) (*Preferences, error)
+	// UpdatePreferences merges the categories, and the channels of each category, into
+	// the preferences of the ref and returns the updated preferences. Messages of the
+	// categories the ref opted out of are not sent to its instances
+	UpdatePreferences(context.Context, *Preferences) (*Preferences, error)
+	// CleanupInstances removes tokens of instances not seen for the token_max_age and
+	// instances not seen for the instance_max_age. Remaining tokens can be validated
+	// by a dry run send, removing the tokens rejected by FCM.
+	// This endpoint is meant to be called periodically, e.g. by Cloud Scheduler
+	CleanupInstances(context.Context, *CleanupInstancesRequest) (*CleanupReport, error)
 	// Send sends a single notification with its data either to a token, topic, or a condition (e.g. more topics)
 	// see https://pkg.go.dev/firebase.google.com/go/messaging#Client.Send
 	// This is a Pub/Sub optimized endpoint
@@ -149,8 +484,32 @@ type NotificationServiceServer interface {
 	// see https://pkg.go.dev/firebase.google.com/go/messaging#Client.SendMulticast
 	// This is a Pub/Sub optimized endpoint
 	SendMulticast(context.Context, *SendMulticastRequest) (*empty.Empty, error)
-	// ListNotifications returns list of notifications with a paging token
+	// CountAudience returns the number of instances and tokens matching the audience
+	// expression, so the reach of a message can be previewed before sending it
+	CountAudience(context.Context, *CountAudienceRequest) (*AudienceCount, error)
+	// CreateSegment stores a named audience that can be targeted by multicast messages.
+	// The id is generated if not set
+	CreateSegment(context.Context, *Segment) (*Segment, error)
+	// ListSegments returns the segments ordered by their id with a paging token
+	ListSegments(context.Context, *ListSegmentsRequest) (*SegmentList, error)
+	// DeleteSegment removes the segment
+	DeleteSegment(context.Context, *DeleteSegmentRequest) (*empty.Empty, error)
+	// ListScheduled returns the scheduled notifications ordered by their send_at time
+	// with a paging token
+	ListScheduled(context.Context, *ListScheduledRequest) (*ScheduledNotificationList, error)
+	// CancelScheduled cancels a pending scheduled notification
+	CancelScheduled(context.Context, *CancelScheduledRequest) (*empty.Empty, error)
+	// ListNotifications returns the history of the notifications, including the ones
+	// suppressed by the frequency caps and the failed ones, in a descending list with
+	// a paging token
 	ListNotifications(context.Context, *ListNotificationsRequest) (*NotificationList, error)
+	// ListDeadLetters returns Pub/Sub delivered requests that failed permanently (e.g. validation
+	// or an unknown template) in a descending list with a paging token.
+	// These messages were acknowledged so Pub/Sub doesn't retry them forever
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*DeadLetterList, error)
+	// ReplayDeadLetter dispatches the original payload of the dead letter to its method again.
+	// The dead letter is removed if the replay succeeds
+	ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*empty.Empty, error)
 	mustEmbedUnimplementedNotificationServiceServer()
 }
 
@@ -167,6 +526,39 @@ func (UnimplementedNotificationServiceServer) RemoveToken(context.Context, *Remo
 func (UnimplementedNotificationServiceServer) RemoveInstance(context.Context, *RemoveInstanceRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveInstance not implemented")
 }
+func (UnimplementedNotificationServiceServer) UpdateLabels(context.Context, *UpdateLabelsRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLabels not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeduplicateTokens(context.Context, *DeduplicateTokensRequest) (*DeduplicateTokensReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeduplicateTokens not implemented")
+}
+func (UnimplementedNotificationServiceServer) ImportInstances(NotificationService_ImportInstancesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportInstances not implemented")
+}
+func (UnimplementedNotificationServiceServer) ExportInstances(*ExportInstancesRequest, NotificationService_ExportInstancesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportInstances not implemented")
+}
+func (UnimplementedNotificationServiceServer) GetInstance(context.Context, *GetInstanceRequest) (*AppInstance, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstance not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListInstances(context.Context, *ListInstancesRequest) (*AppInstanceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstances not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListUserDevices(context.Context, *ListUserDevicesRequest) (*AppInstanceList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserDevices not implemented")
+}
+func (UnimplementedNotificationServiceServer) RemoveUser(context.Context, *RemoveUserRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveUser not implemented")
+}
+func (UnimplementedNotificationServiceServer) GetPreferences(context.Context, *GetPreferencesRequest) (*Preferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedNotificationServiceServer) UpdatePreferences(context.Context, *Preferences) (*Preferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePreferences not implemented")
+}
+func (UnimplementedNotificationServiceServer) CleanupInstances(context.Context, *CleanupInstancesRequest) (*CleanupReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CleanupInstances not implemented")
+}
 func (UnimplementedNotificationServiceServer) Send(context.Context, *SendRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
@@ -176,9 +568,33 @@ func (UnimplementedNotificationServiceServer) SendAll(context.Context, *SendAllR
 func (UnimplementedNotificationServiceServer) SendMulticast(context.Context, *SendMulticastRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendMulticast not implemented")
 }
+func (UnimplementedNotificationServiceServer) CountAudience(context.Context, *CountAudienceRequest) (*AudienceCount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountAudience not implemented")
+}
+func (UnimplementedNotificationServiceServer) CreateSegment(context.Context, *Segment) (*Segment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSegment not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListSegments(context.Context, *ListSegmentsRequest) (*SegmentList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSegments not implemented")
+}
+func (UnimplementedNotificationServiceServer) DeleteSegment(context.Context, *DeleteSegmentRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSegment not implemented")
+}
+func (UnimplementedNotificationServiceServer) ListScheduled(context.Context, *ListScheduledRequest) (*ScheduledNotificationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduled not implemented")
+}
+func (UnimplementedNotificationServiceServer) CancelScheduled(context.Context, *CancelScheduledRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduled not implemented")
+}
 func (UnimplementedNotificationServiceServer) ListNotifications(context.Context, *ListNotificationsRequest) (*NotificationList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
 }
+func (UnimplementedNotificationServiceServer) ListDeadLetters(context.Context, *ListDeadLettersRequest) (*DeadLetterList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedNotificationServiceServer) ReplayDeadLetter(context.Context, *ReplayDeadLetterRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetter not implemented")
+}
 func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
 
 // UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -246,6 +662,215 @@ func _NotificationService_RemoveInstance_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_UpdateLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLabelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdateLabels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/UpdateLabels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdateLabels(ctx, req.(*UpdateLabelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeduplicateTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeduplicateTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeduplicateTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/DeduplicateTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeduplicateTokens(ctx, req.(*DeduplicateTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ImportInstances_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NotificationServiceServer).ImportInstances(&notificationServiceImportInstancesServer{stream})
+}
+
+type NotificationService_ImportInstancesServer interface {
+	SendAndClose(*ImportReport) error
+	Recv() (*AppInstance, error)
+	grpc.ServerStream
+}
+
+type notificationServiceImportInstancesServer struct {
+	grpc.ServerStream
+}
+
+func (x *notificationServiceImportInstancesServer) SendAndClose(m *ImportReport) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *notificationServiceImportInstancesServer) Recv() (*AppInstance, error) {
+	m := new(AppInstance)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _NotificationService_ExportInstances_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportInstancesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotificationServiceServer).ExportInstances(m, &notificationServiceExportInstancesServer{stream})
+}
+
+type NotificationService_ExportInstancesServer interface {
+	Send(*AppInstance) error
+	grpc.ServerStream
+}
+
+type notificationServiceExportInstancesServer struct {
+	grpc.ServerStream
+}
+
+func (x *notificationServiceExportInstancesServer) Send(m *AppInstance) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _NotificationService_GetInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/GetInstance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetInstance(ctx, req.(*GetInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/ListInstances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListInstances(ctx, req.(*ListInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListUserDevices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListUserDevices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/ListUserDevices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListUserDevices(ctx, req.(*ListUserDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_RemoveUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).RemoveUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/RemoveUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).RemoveUser(ctx, req.(*RemoveUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/GetPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetPreferences(ctx, req.(*GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_UpdatePreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Preferences)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).UpdatePreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/UpdatePreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).UpdatePreferences(ctx, req.(*Preferences))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CleanupInstances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CleanupInstancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CleanupInstances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/CleanupInstances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CleanupInstances(ctx, req.(*CleanupInstancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NotificationService_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendRequest)
 	if err := dec(in); err != nil {
@@ -300,6 +925,114 @@ func _NotificationService_SendMulticast_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_CountAudience_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountAudienceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CountAudience(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/CountAudience",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CountAudience(ctx, req.(*CountAudienceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CreateSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Segment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CreateSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/CreateSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CreateSegment(ctx, req.(*Segment))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/ListSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListSegments(ctx, req.(*ListSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_DeleteSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).DeleteSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/DeleteSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).DeleteSegment(ctx, req.(*DeleteSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ListScheduled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListScheduled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/ListScheduled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListScheduled(ctx, req.(*ListScheduledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_CancelScheduled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).CancelScheduled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/CancelScheduled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).CancelScheduled(ctx, req.(*CancelScheduledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _NotificationService_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListNotificationsRequest)
 	if err := dec(in); err != nil {
@@ -318,6 +1051,42 @@ func _NotificationService_ListNotifications_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/ListDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_ReplayDeadLetter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLetterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ReplayDeadLetter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/fcmcompanion.v1.NotificationService/ReplayDeadLetter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ReplayDeadLetter(ctx, req.(*ReplayDeadLetterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NotificationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "fcmcompanion.v1.NotificationService",
 	HandlerType: (*NotificationServiceServer)(nil),
@@ -334,6 +1103,42 @@ var _NotificationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveInstance",
 			Handler:    _NotificationService_RemoveInstance_Handler,
 		},
+		{
+			MethodName: "UpdateLabels",
+			Handler:    _NotificationService_UpdateLabels_Handler,
+		},
+		{
+			MethodName: "DeduplicateTokens",
+			Handler:    _NotificationService_DeduplicateTokens_Handler,
+		},
+		{
+			MethodName: "GetInstance",
+			Handler:    _NotificationService_GetInstance_Handler,
+		},
+		{
+			MethodName: "ListInstances",
+			Handler:    _NotificationService_ListInstances_Handler,
+		},
+		{
+			MethodName: "ListUserDevices",
+			Handler:    _NotificationService_ListUserDevices_Handler,
+		},
+		{
+			MethodName: "RemoveUser",
+			Handler:    _NotificationService_RemoveUser_Handler,
+		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _NotificationService_GetPreferences_Handler,
+		},
+		{
+			MethodName: "UpdatePreferences",
+			Handler:    _NotificationService_UpdatePreferences_Handler,
+		},
+		{
+			MethodName: "CleanupInstances",
+			Handler:    _NotificationService_CleanupInstances_Handler,
+		},
 		{
 			MethodName: "Send",
 			Handler:    _NotificationService_Send_Handler,
@@ -346,11 +1151,54 @@ var _NotificationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendMulticast",
 			Handler:    _NotificationService_SendMulticast_Handler,
 		},
+		{
+			MethodName: "CountAudience",
+			Handler:    _NotificationService_CountAudience_Handler,
+		},
+		{
+			MethodName: "CreateSegment",
+			Handler:    _NotificationService_CreateSegment_Handler,
+		},
+		{
+			MethodName: "ListSegments",
+			Handler:    _NotificationService_ListSegments_Handler,
+		},
+		{
+			MethodName: "DeleteSegment",
+			Handler:    _NotificationService_DeleteSegment_Handler,
+		},
+		{
+			MethodName: "ListScheduled",
+			Handler:    _NotificationService_ListScheduled_Handler,
+		},
+		{
+			MethodName: "CancelScheduled",
+			Handler:    _NotificationService_CancelScheduled_Handler,
+		},
 		{
 			MethodName: "ListNotifications",
 			Handler:    _NotificationService_ListNotifications_Handler,
 		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _NotificationService_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetter",
+			Handler:    _NotificationService_ReplayDeadLetter_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportInstances",
+			Handler:       _NotificationService_ImportInstances_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportInstances",
+			Handler:       _NotificationService_ExportInstances_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/notification.proto",
 }
@@ -0,0 +1,11 @@
+package v1
+
+import (
+	_ "embed"
+)
+
+// OpenAPISpec is the OpenAPI v2 (swagger) document of the gateway routes,
+// generated from the notification.proto with `make gen`
+//
+//go:embed notification.swagger.json
+var OpenAPISpec []byte
@@ -3,15 +3,22 @@ package main
 import (
 	"context"
 	"github.com/blendle/zapdriver"
+	v1 "github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
 	"github.com/petomalina/fcm-companion/pkg/companion"
 	"github.com/petomalina/fcm-companion/pkg/serverutil"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"os"
+	"strconv"
+	"time"
+
+	// timezones are embedded for the local time delivery, as the image may not include them
+	_ "time/tzdata"
 )
 
 func main() {
-	ctx := context.Background()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
 	config := zapdriver.NewProductionConfig()
 	config.Level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
@@ -28,6 +35,46 @@ func main() {
 		logger.Fatal("Cannot initialize companion", zap.Error(err))
 	}
 
+	svc.TokenConflict, err = companion.ParseTokenConflict(os.Getenv("TOKEN_CONFLICT"))
+	if err != nil {
+		logger.Fatal("Cannot parse the token conflict", zap.Error(err))
+	}
+	svc.MaxDevicesPerRef, _ = strconv.Atoi(os.Getenv("MAX_DEVICES_PER_REF"))
+	svc.TokenMaxAge, _ = time.ParseDuration(os.Getenv("TOKEN_MAX_AGE"))
+	svc.InstanceMaxAge, _ = time.ParseDuration(os.Getenv("INSTANCE_MAX_AGE"))
+
+	// the rate of messages sent to FCM is shared by all requests of the replica. SEND_RATE
+	// is per replica, so it is the FCM quota divided by the max number of replicas
+	if sendRate, _ := strconv.ParseFloat(os.Getenv("SEND_RATE"), 64); sendRate > 0 {
+		svc.SendLimiter = companion.NewSendLimiter(sendRate)
+	}
+	if attempts, _ := strconv.Atoi(os.Getenv("SEND_MAX_ATTEMPTS")); attempts > 0 {
+		retry := companion.DefaultRetryPolicy
+		retry.MaxAttempts = attempts
+		svc.RetryPolicy = &retry
+	}
+
+	// scheduled notifications are sent by every replica, leasing each notification
+	schedulerInterval, _ := time.ParseDuration(os.Getenv("SCHEDULER_INTERVAL"))
+	go svc.RunScheduler(ctx, schedulerInterval)
+
+	// pull subscriptions are optional and run alongside the server
+	if subs := os.Getenv("SUBSCRIPTIONS"); subs != "" {
+		subscriptions, err := companion.ParseSubscriptions(subs)
+		if err != nil {
+			logger.Fatal("Cannot parse subscriptions", zap.Error(err))
+		}
+
+		maxHandlers, _ := strconv.Atoi(os.Getenv("SUBSCRIPTION_MAX_HANDLERS"))
+
+		logger.Info("Starting the subscriber", zap.Int("subscriptions", len(subscriptions)))
+		go func() {
+			if err := svc.Subscribe(ctx, subscriptions, maxHandlers); err != nil {
+				logger.Fatal("Subscriber crashed", zap.Error(err))
+			}
+		}()
+	}
+
 	logger.Info("Starting the server")
 	if err := serverutil.Serve(
 		serverutil.WithContext(ctx),
@@ -35,6 +82,10 @@ func main() {
 		serverutil.WithServices(svc),
 		serverutil.WithGRPC(),
 		serverutil.WithPubSub(),
+		serverutil.WithPubSubMiddleware(svc.PushHandler),
+		serverutil.WithOpenAPI(v1.OpenAPISpec),
+		serverutil.WithDocs(),
+		serverutil.WithOnExit(cancel),
 	); err != nil {
 		logger.Fatal("Serving crashed", zap.Error(err))
 	}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	v1 "github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"github.com/petomalina/fcm-companion/pkg/companion"
+	"go.uber.org/zap"
+	"io"
+	"os"
+)
+
+const usage = `Usage: instances [-format jsonl|csv] [-start-after instance_id] import|export <file>
+
+Imports instances from the file, or exports all instances to the file.
+Use - as the file to read from stdin or write to stdout.
+The project is configured by the PROJECT_ID environment variable.
+`
+
+func main() {
+	format := flag.String("format", companion.FormatJSONL, "format of the file, jsonl or csv")
+	startAfter := flag.String("start-after", "", "resumes the export after the instance_id")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+
+	logger, err := zap.NewDevelopment()
+	if err != nil {
+		panic(err)
+	}
+
+	svc, err := companion.New(ctx, os.Getenv("PROJECT_ID"), logger, "")
+	if err != nil {
+		logger.Fatal("Cannot initialize companion", zap.Error(err))
+	}
+
+	switch flag.Arg(0) {
+	case "import":
+		err = importInstances(ctx, svc, logger, flag.Arg(1), *format)
+	case "export":
+		err = exportInstances(ctx, svc, logger, flag.Arg(1), *format, *startAfter)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		logger.Fatal("Command failed", zap.String("command", flag.Arg(0)), zap.Error(err))
+	}
+}
+
+func importInstances(ctx context.Context, svc *companion.Service, logger *zap.Logger, file, format string) error {
+	var r io.Reader = os.Stdin
+	if file != "-" {
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	reader, err := companion.NewInstanceReader(r, format)
+	if err != nil {
+		return err
+	}
+
+	report, err := svc.Import(ctx, reader, func(report *v1.ImportReport) {
+		logger.Info("Import progress",
+			zap.Int64("imported", report.Imported),
+			zap.Int64("failed", report.Failed),
+		)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, e := range report.Errors {
+		logger.Warn("Row was skipped",
+			zap.Int64("row", e.Row),
+			zap.String("instanceID", e.InstanceId),
+			zap.String("reason", e.Reason),
+		)
+	}
+
+	return nil
+}
+
+func exportInstances(ctx context.Context, svc *companion.Service, logger *zap.Logger, file, format, startAfter string) error {
+	var w io.Writer = os.Stdout
+	if file != "-" {
+		f, err := os.Create(file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	writer, err := companion.NewInstanceWriter(w, format)
+	if err != nil {
+		return err
+	}
+
+	exported, err := svc.Export(ctx, startAfter, writer.Write)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Instances were exported", zap.Int64("exported", exported))
+
+	return writer.Flush()
+}
@@ -1,11 +1,12 @@
 module github.com/petomalina/fcm-companion
 
-go 1.15
+go 1.16
 
 require (
 	cloud.google.com/go/firestore v1.3.0
 	firebase.google.com/go v3.13.0+incompatible // indirect
 	firebase.google.com/go/v4 v4.0.0
+	github.com/blendle/zapdriver v1.3.1
 	github.com/envoyproxy/protoc-gen-validate v0.4.1
 	github.com/golang/protobuf v1.4.2
 	github.com/grpc-ecosystem/grpc-gateway v1.15.0
@@ -15,6 +16,7 @@ require (
 	golang.org/x/net v0.0.0-20200927032502-5d4f70055728 // indirect
 	golang.org/x/sys v0.0.0-20200929083018-4d22bbb62b3c // indirect
 	golang.org/x/text v0.3.3 // indirect
+	golang.org/x/time v0.0.0-20210220033141-f8bda1e9f3ba
 	golang.org/x/tools v0.0.0-20201002055958-0d28ed0cbe40 // indirect
 	google.golang.org/api v0.32.0
 	google.golang.org/genproto v0.0.0-20200929141702-51c3e5b607fe
@@ -0,0 +1,226 @@
+// Package audience implements the audience expressions used to target instances, e.g.
+//
+//	ref in ("u1", "u2") AND labels.plan = "pro" AND platform = "ios"
+//
+// Expressions combine comparisons of instance fields with AND, OR, NOT and parentheses.
+// Supported operators are =, !=, IN and NOT IN for all fields, and <, <=, >, >= for
+// the timestamp fields, which are compared to RFC 3339 values
+package audience
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"strings"
+	"time"
+)
+
+// Op is the operator of a comparison
+type Op string
+
+const (
+	OpEq    Op = "="
+	OpNe    Op = "!="
+	OpLt    Op = "<"
+	OpLte   Op = "<="
+	OpGt    Op = ">"
+	OpGte   Op = ">="
+	OpIn    Op = "IN"
+	OpNotIn Op = "NOT IN"
+)
+
+// LabelsPrefix is the prefix of the label fields, e.g. labels.plan
+const LabelsPrefix = "labels."
+
+// stringFields are the string fields of the instance that can be compared
+var stringFields = map[string]func(*v1.AppInstance) string{
+	"instance_id": func(i *v1.AppInstance) string { return i.InstanceId },
+	"token":       func(i *v1.AppInstance) string { return i.Token },
+	"ref":         func(i *v1.AppInstance) string { return i.Ref },
+	"app_version": func(i *v1.AppInstance) string { return i.AppVersion },
+	"os_version":  func(i *v1.AppInstance) string { return i.OsVersion },
+	"sdk_version": func(i *v1.AppInstance) string { return i.SdkVersion },
+	"timezone":    func(i *v1.AppInstance) string { return i.Timezone },
+	"platform":    func(i *v1.AppInstance) string { return strings.ToLower(i.Platform.String()) },
+}
+
+// timeFields are the timestamp fields of the instance that can be compared
+var timeFields = map[string]func(*v1.AppInstance) *timestamp.Timestamp{
+	"created_at":       func(i *v1.AppInstance) *timestamp.Timestamp { return i.CreatedAt },
+	"updated_at":       func(i *v1.AppInstance) *timestamp.Timestamp { return i.UpdatedAt },
+	"last_seen_at":     func(i *v1.AppInstance) *timestamp.Timestamp { return i.LastSeenAt },
+	"token_updated_at": func(i *v1.AppInstance) *timestamp.Timestamp { return i.TokenUpdatedAt },
+}
+
+// Expr is a parsed audience expression
+type Expr interface {
+	// Match returns true if the instance belongs to the audience
+	Match(i *v1.AppInstance) bool
+	String() string
+}
+
+// And matches instances matched by all of the expressions
+type And []Expr
+
+func (a And) Match(i *v1.AppInstance) bool {
+	for _, e := range a {
+		if !e.Match(i) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a And) String() string {
+	return join(a, " AND ")
+}
+
+// Or matches instances matched by any of the expressions
+type Or []Expr
+
+func (o Or) Match(i *v1.AppInstance) bool {
+	for _, e := range o {
+		if e.Match(i) {
+			return true
+		}
+	}
+	return false
+}
+
+func (o Or) String() string {
+	return join(o, " OR ")
+}
+
+// Not matches instances not matched by the expression
+type Not struct {
+	Expr Expr
+}
+
+func (n *Not) Match(i *v1.AppInstance) bool {
+	return !n.Expr.Match(i)
+}
+
+func (n *Not) String() string {
+	return "NOT " + join([]Expr{n.Expr}, "")
+}
+
+// Comparison compares a field of the instance to the values
+type Comparison struct {
+	Field  string
+	Op     Op
+	Values []string
+
+	// time is the parsed value of comparisons of the timestamp fields
+	time time.Time
+}
+
+func (c *Comparison) Match(i *v1.AppInstance) bool {
+	if get, ok := timeFields[c.Field]; ok {
+		return c.matchTime(get(i))
+	}
+
+	value := c.value(i)
+	switch c.Op {
+	case OpEq:
+		return value == c.Values[0]
+	case OpNe:
+		return value != c.Values[0]
+	case OpIn, OpNotIn:
+		in := false
+		for _, v := range c.Values {
+			if value == v {
+				in = true
+				break
+			}
+		}
+		return in == (c.Op == OpIn)
+	}
+
+	return false
+}
+
+func (c *Comparison) matchTime(ts *timestamp.Timestamp) bool {
+	// instances without the timestamp never match
+	if ts == nil {
+		return false
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return false
+	}
+
+	switch c.Op {
+	case OpEq:
+		return t.Equal(c.time)
+	case OpNe:
+		return !t.Equal(c.time)
+	case OpLt:
+		return t.Before(c.time)
+	case OpLte:
+		return !t.After(c.time)
+	case OpGt:
+		return t.After(c.time)
+	case OpGte:
+		return !t.Before(c.time)
+	}
+
+	return false
+}
+
+// value returns the value of the compared string field of the instance
+func (c *Comparison) value(i *v1.AppInstance) string {
+	if strings.HasPrefix(c.Field, LabelsPrefix) {
+		return i.Labels[strings.TrimPrefix(c.Field, LabelsPrefix)]
+	}
+
+	return stringFields[c.Field](i)
+}
+
+func (c *Comparison) String() string {
+	quoted := make([]string, len(c.Values))
+	for i, v := range c.Values {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+
+	if c.Op == OpIn || c.Op == OpNotIn {
+		return fmt.Sprintf("%s %s (%s)", c.Field, c.Op, strings.Join(quoted, ", "))
+	}
+	return fmt.Sprintf("%s %s %s", c.Field, c.Op, quoted[0])
+}
+
+// IsTime returns true if the field of the comparison is a timestamp
+func (c *Comparison) IsTime() bool {
+	_, ok := timeFields[c.Field]
+	return ok
+}
+
+// Conjuncts returns the comparisons that must all match for the expression to match,
+// i.e. the comparisons of the top-level AND. These can be used to narrow down the
+// queried instances before matching the whole expression
+func Conjuncts(e Expr) []*Comparison {
+	switch e := e.(type) {
+	case *Comparison:
+		return []*Comparison{e}
+	case And:
+		var comparisons []*Comparison
+		for _, sub := range e {
+			comparisons = append(comparisons, Conjuncts(sub)...)
+		}
+		return comparisons
+	}
+
+	return nil
+}
+
+func join(exprs []Expr, sep string) string {
+	parts := make([]string, len(exprs))
+	for i, e := range exprs {
+		parts[i] = e.String()
+		if _, ok := e.(*Comparison); !ok {
+			parts[i] = "(" + parts[i] + ")"
+		}
+	}
+
+	return strings.Join(parts, sep)
+}
@@ -0,0 +1,95 @@
+package audience
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLex(t *testing.T) {
+	tests := []struct {
+		name   string
+		expr   string
+		tokens []token
+	}{
+		{
+			name: "comparison",
+			expr: `labels.plan = "pro"`,
+			tokens: []token{
+				{kind: tokenIdent, value: "labels.plan", pos: 0},
+				{kind: tokenOperator, value: "=", pos: 12},
+				{kind: tokenString, value: "pro", pos: 14},
+				{kind: tokenEOF, pos: 19},
+			},
+		},
+		{
+			name: "two character operators",
+			expr: `a!="" b<="" c>=""`,
+			tokens: []token{
+				{kind: tokenIdent, value: "a", pos: 0},
+				{kind: tokenOperator, value: "!=", pos: 1},
+				{kind: tokenString, value: "", pos: 3},
+				{kind: tokenIdent, value: "b", pos: 6},
+				{kind: tokenOperator, value: "<=", pos: 7},
+				{kind: tokenString, value: "", pos: 9},
+				{kind: tokenIdent, value: "c", pos: 12},
+				{kind: tokenOperator, value: ">=", pos: 13},
+				{kind: tokenString, value: "", pos: 15},
+				{kind: tokenEOF, pos: 17},
+			},
+		},
+		{
+			name: "list",
+			expr: `ref IN ("u1","u2")`,
+			tokens: []token{
+				{kind: tokenIdent, value: "ref", pos: 0},
+				{kind: tokenIdent, value: "IN", pos: 4},
+				{kind: tokenLParen, value: "(", pos: 7},
+				{kind: tokenString, value: "u1", pos: 8},
+				{kind: tokenComma, value: ",", pos: 12},
+				{kind: tokenString, value: "u2", pos: 13},
+				{kind: tokenRParen, value: ")", pos: 17},
+				{kind: tokenEOF, pos: 18},
+			},
+		},
+		{
+			name: "escaped quote",
+			expr: `"a\"b"`,
+			tokens: []token{
+				{kind: tokenString, value: `a"b`, pos: 0},
+				{kind: tokenEOF, pos: 6},
+			},
+		},
+		{
+			name:   "empty",
+			expr:   "  ",
+			tokens: []token{{kind: tokenEOF, pos: 2}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := lex(tt.expr)
+			if err != nil {
+				t.Fatalf("lex(%q) failed: %v", tt.expr, err)
+			}
+			if !reflect.DeepEqual(tokens, tt.tokens) {
+				t.Errorf("lex(%q) = %+v, want %+v", tt.expr, tokens, tt.tokens)
+			}
+		})
+	}
+}
+
+func TestLexErrors(t *testing.T) {
+	for _, expr := range []string{
+		`a == "b"`,
+		`a ! "b"`,
+		`a = "b`,
+		`a = "\q"`,
+		`a = 'b'`,
+		`a = "b" ; c`,
+	} {
+		if _, err := lex(expr); err == nil {
+			t.Errorf("lex(%q) succeeded, want an error", expr)
+		}
+	}
+}
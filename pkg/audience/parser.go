@@ -0,0 +1,223 @@
+package audience
+
+import (
+	"fmt"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"strings"
+	"time"
+)
+
+// Parse parses and validates the audience expression
+func Parse(s string) (Expr, error) {
+	tokens, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	e, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %q at %d", t.value, t.pos)
+	}
+
+	return e, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) expect(kind tokenKind, what string) (token, error) {
+	t := p.next()
+	if t.kind != kind {
+		return t, fmt.Errorf("expected %s at %d", what, t.pos)
+	}
+	return t, nil
+}
+
+// or := and ("OR" and)*
+func (p *parser) or() (Expr, error) {
+	e, err := p.and()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := Or{e}
+	for p.peek().isKeyword("OR") {
+		p.next()
+		e, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+	}
+
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return exprs, nil
+}
+
+// and := unary ("AND" unary)*
+func (p *parser) and() (Expr, error) {
+	e, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+
+	exprs := And{e}
+	for p.peek().isKeyword("AND") {
+		p.next()
+		e, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, e)
+	}
+
+	if len(exprs) == 1 {
+		return exprs[0], nil
+	}
+	return exprs, nil
+}
+
+// unary := "NOT" unary | "(" or ")" | comparison
+func (p *parser) unary() (Expr, error) {
+	t := p.peek()
+
+	switch {
+	case t.isKeyword("NOT"):
+		p.next()
+		e, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: e}, nil
+
+	case t.kind == tokenLParen:
+		p.next()
+		e, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenRParen, ")"); err != nil {
+			return nil, err
+		}
+		return e, nil
+	}
+
+	return p.comparison()
+}
+
+// comparison := field operator string | field ["NOT"] "IN" "(" string ("," string)* ")"
+func (p *parser) comparison() (Expr, error) {
+	field, err := p.expect(tokenIdent, "a field")
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Comparison{Field: field.value}
+
+	t := p.next()
+	switch {
+	case t.kind == tokenOperator:
+		c.Op = Op(t.value)
+		value, err := p.expect(tokenString, "a quoted value")
+		if err != nil {
+			return nil, err
+		}
+		c.Values = []string{value.value}
+
+	case t.isKeyword("IN"), t.isKeyword("NOT"):
+		c.Op = OpIn
+		if t.isKeyword("NOT") {
+			if !p.next().isKeyword("IN") {
+				return nil, fmt.Errorf("expected IN at %d", t.pos)
+			}
+			c.Op = OpNotIn
+		}
+
+		if _, err := p.expect(tokenLParen, "("); err != nil {
+			return nil, err
+		}
+		for {
+			value, err := p.expect(tokenString, "a quoted value")
+			if err != nil {
+				return nil, err
+			}
+			c.Values = append(c.Values, value.value)
+
+			if p.peek().kind != tokenComma {
+				break
+			}
+			p.next()
+		}
+		if _, err := p.expect(tokenRParen, ")"); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("expected an operator at %d", t.pos)
+	}
+
+	if err := c.validate(); err != nil {
+		return nil, fmt.Errorf("invalid comparison of %s at %d: %v", c.Field, field.pos, err)
+	}
+
+	return c, nil
+}
+
+// validate checks the field, operator and values of the comparison
+func (c *Comparison) validate() error {
+	if _, ok := timeFields[c.Field]; ok {
+		if c.Op == OpIn || c.Op == OpNotIn {
+			return fmt.Errorf("%s is not supported for timestamps", c.Op)
+		}
+
+		t, err := time.Parse(time.RFC3339, c.Values[0])
+		if err != nil {
+			return fmt.Errorf("expected an RFC 3339 timestamp: %v", err)
+		}
+		c.time = t
+		return nil
+	}
+
+	_, known := stringFields[c.Field]
+	if !known && !(strings.HasPrefix(c.Field, LabelsPrefix) && len(c.Field) > len(LabelsPrefix)) {
+		return fmt.Errorf("unknown field")
+	}
+
+	switch c.Op {
+	case OpEq, OpNe, OpIn, OpNotIn:
+	default:
+		return fmt.Errorf("%s is only supported for timestamps", c.Op)
+	}
+
+	if c.Field == "platform" {
+		for i, v := range c.Values {
+			if _, ok := v1.Platform_value[strings.ToUpper(v)]; !ok {
+				return fmt.Errorf("unknown platform %q", v)
+			}
+			c.Values[i] = strings.ToLower(v)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package audience
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{`ref = "u1"`, `ref = "u1"`},
+		{`labels.plan != "free"`, `labels.plan != "free"`},
+		{`ref in ("u1", "u2")`, `ref IN ("u1", "u2")`},
+		{`ref not in ("u1")`, `ref NOT IN ("u1")`},
+		{`platform = "IOS"`, `platform = "ios"`},
+		{`labels.b = "1" and ref = "u1" or ref = "u2"`, `(labels.b = "1" AND ref = "u1") OR ref = "u2"`},
+		{`ref = "u1" AND (ref = "u2" OR ref = "u3")`, `ref = "u1" AND (ref = "u2" OR ref = "u3")`},
+		{`NOT ref = "u1"`, `NOT ref = "u1"`},
+		{`last_seen_at >= "2020-10-01T00:00:00Z"`, `last_seen_at >= "2020-10-01T00:00:00Z"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			e, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", tt.expr, err)
+			}
+			if e.String() != tt.want {
+				t.Errorf("Parse(%q) = %s, want %s", tt.expr, e, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, expr := range []string{
+		``,
+		`ref`,
+		`ref =`,
+		`ref = u1`,
+		`ref = "u1" AND`,
+		`(ref = "u1"`,
+		`ref = "u1")`,
+		`ref IN ()`,
+		`ref IN ("u1",)`,
+		`ref NOT = "u1"`,
+		`unknown = "x"`,
+		`labels. = "x"`,
+		`ref < "u1"`,
+		`platform = "symbian"`,
+		`created_at = "yesterday"`,
+		`created_at IN ("2020-10-01T00:00:00Z")`,
+	} {
+		if _, err := Parse(expr); err == nil {
+			t.Errorf("Parse(%q) succeeded, want an error", expr)
+		}
+	}
+}
+
+func TestMatch(t *testing.T) {
+	seen, _ := ptypes.TimestampProto(time.Date(2020, 10, 2, 0, 0, 0, 0, time.UTC))
+	i := &v1.AppInstance{
+		Ref:        "u1",
+		Platform:   v1.Platform_IOS,
+		Labels:     map[string]string{"plan": "pro"},
+		LastSeenAt: seen,
+	}
+
+	tests := []struct {
+		expr  string
+		match bool
+	}{
+		{`ref = "u1"`, true},
+		{`ref != "u1"`, false},
+		{`ref IN ("u2", "u1")`, true},
+		{`ref NOT IN ("u2", "u1")`, false},
+		{`platform = "ios" AND labels.plan = "pro"`, true},
+		{`labels.missing = ""`, true},
+		{`ref = "u2" OR labels.plan = "pro"`, true},
+		{`NOT (ref = "u2" OR labels.plan = "pro")`, false},
+		{`last_seen_at > "2020-10-01T00:00:00Z"`, true},
+		{`last_seen_at < "2020-10-01T00:00:00Z"`, false},
+		{`created_at < "2020-10-01T00:00:00Z"`, false},
+		{`created_at != "2020-10-01T00:00:00Z"`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			e, err := Parse(tt.expr)
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", tt.expr, err)
+			}
+			if match := e.Match(i); match != tt.match {
+				t.Errorf("%s matched %v, want %v", tt.expr, match, tt.match)
+			}
+		})
+	}
+}
+
+func TestConjuncts(t *testing.T) {
+	e, err := Parse(`ref = "u1" AND (platform = "ios" AND labels.plan = "pro") AND (ref = "u2" OR ref = "u3")`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fields []string
+	for _, c := range Conjuncts(e) {
+		fields = append(fields, c.Field)
+	}
+
+	want := []string{"ref", "platform", "labels.plan"}
+	if len(fields) != len(want) {
+		t.Fatalf("Conjuncts = %v, want %v", fields, want)
+	}
+	for i := range want {
+		if fields[i] != want[i] {
+			t.Errorf("Conjuncts = %v, want %v", fields, want)
+		}
+	}
+}
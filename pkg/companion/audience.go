@@ -0,0 +1,263 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"firebase.google.com/go/v4/messaging"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"github.com/petomalina/fcm-companion/pkg/audience"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	// maxInValues is the maximum number of values of the Firestore "in" filter
+	maxInValues = 10
+)
+
+// audienceFields maps the audience fields that can be queried to their stored names
+var audienceFields = map[string]string{
+	"token":       "token",
+	"ref":         "ref",
+	"platform":    "platform",
+	"app_version": "appVersion",
+	"os_version":  "osVersion",
+	"sdk_version": "sdkVersion",
+	"timezone":    "timezone",
+}
+
+func (s *Service) CountAudience(ctx context.Context, r *v1.CountAudienceRequest) (*v1.AudienceCount, error) {
+	if err := r.Validate(); err != nil {
+		return &v1.AudienceCount{}, err
+	}
+
+	e, err := parseAudience(r.Audience)
+	if err != nil {
+		return &v1.AudienceCount{}, err
+	}
+
+	count := &v1.AudienceCount{}
+	tokens := map[string]bool{}
+	err = s.matchAudience(ctx, e, func(i *v1.AppInstance) error {
+		count.Instances++
+		if i.Token != "" && !tokens[i.Token] {
+			tokens[i.Token] = true
+			count.Tokens++
+		}
+		return nil
+	})
+	if err != nil {
+		return &v1.AudienceCount{}, err
+	}
+
+	return count, nil
+}
+
+// parseAudience parses the audience expression of a request
+func parseAudience(expr string) (audience.Expr, error) {
+	e, err := audience.Parse(expr)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid audience: %v", err)
+	}
+
+	return e, nil
+}
+
+// audienceQuery narrows down the queried instances by the comparisons all matched
+// instances must satisfy. Comparisons that can't be queried are only matched in memory
+func (s *Service) audienceQuery(e audience.Expr) firestore.Query {
+	q := s.FirestoreClient.Collection(s.CollectionPrefix + instancesCollection).Query
+
+	in := false
+	for _, c := range audience.Conjuncts(e) {
+		path, ok := audiencePath(c.Field)
+		if !ok {
+			continue
+		}
+
+		// empty values can't be queried, as empty fields are not stored
+		values := make([]interface{}, 0, len(c.Values))
+		for _, v := range c.Values {
+			if v == "" {
+				values = nil
+				break
+			}
+			values = append(values, audienceValue(c.Field, v))
+		}
+		if len(values) == 0 {
+			continue
+		}
+
+		switch {
+		case c.Op == audience.OpEq:
+			q = q.WherePath(path, "==", values[0])
+		case c.Op == audience.OpIn && !in && len(values) <= maxInValues:
+			// only a single "in" filter is allowed by Firestore
+			q = q.WherePath(path, "in", values)
+			in = true
+		}
+	}
+
+	return q
+}
+
+// audiencePath returns the stored path of the audience field if it can be queried
+func audiencePath(field string) (firestore.FieldPath, bool) {
+	if strings.HasPrefix(field, audience.LabelsPrefix) {
+		return firestore.FieldPath{"labels", strings.TrimPrefix(field, audience.LabelsPrefix)}, true
+	}
+
+	name, ok := audienceFields[field]
+	return firestore.FieldPath{name}, ok
+}
+
+// audienceValue returns the stored value of the audience field value
+func audienceValue(field, value string) interface{} {
+	if field == "platform" {
+		return v1.Platform(v1.Platform_value[strings.ToUpper(value)])
+	}
+
+	return value
+}
+
+// matchAudience calls the fn with each instance matching the audience. Instances are
+// queried a page at a time, so no query is kept open while the fn sends them
+func (s *Service) matchAudience(ctx context.Context, e audience.Expr, fn func(*v1.AppInstance) error) error {
+	return s.scanInstances(ctx, s.audienceQuery(e), func(snaps []*firestore.DocumentSnapshot) error {
+		for _, snap := range snaps {
+			i, err := instanceFromSnapshot(snap)
+			if err != nil {
+				return err
+			}
+
+			if !e.Match(i) {
+				continue
+			}
+
+			if err := fn(i); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// audienceTokens calls the fn with batches of distinct tokens of the instances matching
+// the audience, so large audiences are never resolved at once
+func (s *Service) audienceTokens(ctx context.Context, e audience.Expr, fn func(tokens []string) error) error {
+	seen := map[string]bool{}
+	batch := make([]string, 0, maxBatchSize)
+
+	err := s.matchAudience(ctx, e, func(i *v1.AppInstance) error {
+		if i.Token == "" || seen[i.Token] {
+			return nil
+		}
+		seen[i.Token] = true
+
+		batch = append(batch, i.Token)
+		if len(batch) < maxBatchSize {
+			return nil
+		}
+
+		err := fn(batch)
+		batch = make([]string, 0, maxBatchSize)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(batch) > 0 {
+		return fn(batch)
+	}
+
+	return nil
+}
+
+// targetTokens calls the fn with batches of tokens of the ref or the audience of the message
+func (s *Service) targetTokens(ctx context.Context, ref, expr string, fn func(tokens []string) error) error {
+	if expr != "" {
+		e, err := parseAudience(expr)
+		if err != nil {
+			return err
+		}
+		return s.audienceTokens(ctx, e, fn)
+	}
+
+	tokens, err := s.refTokens(ctx, ref)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	return fn(tokens)
+}
+
+// multicastTargets sends the message to all tokens of the ref or the audience. Only the
+// failure of all batches is returned, and a NotFound error if there are no tokens
+func (s *Service) multicastTargets(ctx context.Context, ref, expr string, msg *messaging.MulticastMessage, p *sendPolicy) error {
+	var batches, failures int
+	var lastErr error
+
+	err := s.targetTokens(ctx, ref, expr, func(tokens []string) error {
+		batches++
+
+		m := *msg
+		m.Tokens = tokens
+		if err := s.multicast(ctx, &m, p); err != nil {
+			failures++
+			lastErr = err
+		}
+		return nil
+	})
+	if err != nil && batches > 0 {
+		// the previous batches were sent, retries would send them again
+		s.Error("Cannot resolve all targets, message was partially sent",
+			zap.String("ref", ref),
+			zap.String("audience", expr),
+			zap.Int("batches", batches),
+			zap.Error(err),
+		)
+		return partiallySent(err)
+	} else if err != nil {
+		return err
+	}
+
+	switch {
+	case batches == 0 && expr != "":
+		return status.Error(codes.NotFound, "no instances with a token match the audience")
+	case batches == 0:
+		return status.Errorf(codes.NotFound, "ref %q has no instances with a token", ref)
+	case failures == batches:
+		return lastErr
+	}
+
+	return nil
+}
+
+// targetMessages returns a copy of the message for each token of the ref or the audience
+func (s *Service) targetMessages(ctx context.Context, ref, expr string, msg *messaging.Message) ([]*messaging.Message, error) {
+	var msgs []*messaging.Message
+	err := s.targetTokens(ctx, ref, expr, func(tokens []string) error {
+		for _, t := range tokens {
+			m := *msg
+			m.Token = t
+			msgs = append(msgs, &m)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(msgs) == 0 {
+		s.Warn("Message has no target tokens", zap.String("ref", ref), zap.String("audience", expr))
+	}
+
+	return msgs, nil
+}
@@ -0,0 +1,186 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"errors"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/api/iterator"
+	"io"
+)
+
+const (
+	// maxImportErrors limits the number of row errors returned in the ImportReport
+	maxImportErrors = 1000
+)
+
+// InstanceReader reads instances one by one. It returns io.EOF after the last instance
+// and a RowError for instances that can't be decoded
+type InstanceReader func() (*v1.AppInstance, error)
+
+// RowError is an error of a single row of the import. The import skips the row
+// and continues with the next one
+type RowError struct {
+	Err error
+}
+
+func (e *RowError) Error() string {
+	return e.Err.Error()
+}
+
+func (s *Service) ImportInstances(stream v1.NotificationService_ImportInstancesServer) error {
+	report, err := s.Import(stream.Context(), stream.Recv, func(report *v1.ImportReport) {
+		s.Debug("Import progress",
+			zap.Int64("imported", report.Imported),
+			zap.Int64("failed", report.Failed),
+		)
+	})
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(report)
+}
+
+func (s *Service) ExportInstances(r *v1.ExportInstancesRequest, stream v1.NotificationService_ExportInstancesServer) error {
+	if err := r.Validate(); err != nil {
+		return err
+	}
+
+	_, err := s.Export(stream.Context(), r.StartAfter, stream.Send)
+	return err
+}
+
+// Import writes all instances of the reader in batches, replacing existing instances.
+// The progress is called after each written batch
+func (s *Service) Import(ctx context.Context, r InstanceReader, progress func(*v1.ImportReport)) (*v1.ImportReport, error) {
+	report := &v1.ImportReport{}
+
+	batch := s.FirestoreClient.Batch()
+	size := 0
+	commit := func() error {
+		if size == 0 {
+			return nil
+		}
+		if _, err := batch.Commit(ctx); err != nil {
+			return err
+		}
+
+		report.Imported += int64(size)
+		batch = s.FirestoreClient.Batch()
+		size = 0
+
+		if progress != nil {
+			progress(report)
+		}
+		return nil
+	}
+
+	for row := int64(0); ; row++ {
+		i, err := r()
+		if err == io.EOF {
+			break
+		}
+
+		var rowErr *RowError
+		if errors.As(err, &rowErr) {
+			importFailed(report, row, "", err)
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		if err := i.Validate(); err != nil {
+			importFailed(report, row, i.InstanceId, err)
+			continue
+		}
+		if err := validateTimezone(i); err != nil {
+			importFailed(report, row, i.InstanceId, err)
+			continue
+		}
+
+		stampImported(i)
+		batch.Set(s.instanceDoc(i.InstanceId), i)
+		size++
+
+		if size == maxBatchSize {
+			if err := commit(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err := commit(); err != nil {
+		return nil, err
+	}
+
+	s.Info("Instances were imported",
+		zap.Int64("imported", report.Imported),
+		zap.Int64("failed", report.Failed),
+	)
+
+	return report, nil
+}
+
+// Export sends all instances ordered by their instance_id, starting after the startAfter
+// instance_id if set. It returns the number of exported instances
+func (s *Service) Export(ctx context.Context, startAfter string, send func(*v1.AppInstance) error) (int64, error) {
+	q := s.FirestoreClient.Collection(s.CollectionPrefix+instancesCollection).OrderBy(firestore.DocumentID, firestore.Asc)
+	if startAfter != "" {
+		q = q.StartAfter(startAfter)
+	}
+
+	var exported int64
+	it := q.Documents(ctx)
+	defer it.Stop()
+	for {
+		snap, err := it.Next()
+		if err == iterator.Done {
+			return exported, nil
+		} else if err != nil {
+			return exported, err
+		}
+
+		i, err := instanceFromSnapshot(snap)
+		if err != nil {
+			return exported, err
+		}
+
+		if err := send(i); err != nil {
+			return exported, err
+		}
+		exported++
+	}
+}
+
+// importFailed records the error of the row in the report
+func importFailed(report *v1.ImportReport, row int64, instanceID string, err error) {
+	report.Failed++
+	if len(report.Errors) < maxImportErrors {
+		report.Errors = append(report.Errors, &v1.ImportError{
+			Row:        row,
+			InstanceId: instanceID,
+			Reason:     err.Error(),
+		})
+	}
+}
+
+// stampImported sets the server-side timestamps missing in the imported instance.
+// Imported instances are considered seen at the time of the import
+func stampImported(i *v1.AppInstance) {
+	now := ptypes.TimestampNow()
+
+	i.UpdateMask = nil
+	i.UpdatedAt = now
+	if i.CreatedAt == nil {
+		i.CreatedAt = now
+	}
+	if i.LastSeenAt == nil {
+		i.LastSeenAt = now
+	}
+	if i.TokenUpdatedAt == nil && i.Token != "" {
+		i.TokenUpdatedAt = now
+	}
+}
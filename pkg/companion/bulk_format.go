@@ -0,0 +1,259 @@
+package companion
+
+import (
+	"bufio"
+	"encoding/csv"
+	"fmt"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"io"
+	"net/url"
+	"strings"
+)
+
+const (
+	// FormatJSONL is a file with an AppInstance JSON object per line
+	FormatJSONL = "jsonl"
+	// FormatCSV is a file with a header row and the csvColumns of instances.
+	// Labels and quiet hours are URL query encoded, e.g. "lang=en&tier=gold" or
+	// "start=22:00&end=07:00&action=SILENT&timezone=Europe/Prague"
+	FormatCSV = "csv"
+)
+
+// csvColumns are the supported columns of the CSV format
+var csvColumns = []string{"instance_id", "token", "ref", "labels", "platform", "app_version", "os_version", "sdk_version", "timezone", "quiet_hours"}
+
+// InstanceWriter writes instances one by one. Flush must be called after the last instance
+type InstanceWriter interface {
+	Write(*v1.AppInstance) error
+	Flush() error
+}
+
+// NewInstanceReader returns a reader of instances in the format
+func NewInstanceReader(r io.Reader, format string) (InstanceReader, error) {
+	switch format {
+	case FormatJSONL:
+		return jsonlReader(r), nil
+	case FormatCSV:
+		return csvReader(r)
+	}
+
+	return nil, fmt.Errorf("unknown format %q, expected %s or %s", format, FormatJSONL, FormatCSV)
+}
+
+// NewInstanceWriter returns a writer of instances in the format
+func NewInstanceWriter(w io.Writer, format string) (InstanceWriter, error) {
+	switch format {
+	case FormatJSONL:
+		return &jsonlWriter{w: bufio.NewWriter(w)}, nil
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	}
+
+	return nil, fmt.Errorf("unknown format %q, expected %s or %s", format, FormatJSONL, FormatCSV)
+}
+
+func jsonlReader(r io.Reader) InstanceReader {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	return func() (*v1.AppInstance, error) {
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" {
+				continue
+			}
+
+			i := &v1.AppInstance{}
+			if err := jsonpb.UnmarshalString(line, i); err != nil {
+				return nil, &RowError{Err: err}
+			}
+			return i, nil
+		}
+
+		if err := scanner.Err(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+}
+
+func csvReader(r io.Reader) (InstanceReader, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+
+	header, err := cr.Read()
+	if err != nil {
+		return nil, fmt.Errorf("cannot read the header: %v", err)
+	}
+
+	columns := map[string]int{}
+	for i, h := range header {
+		columns[strings.TrimSpace(h)] = i
+	}
+	if _, ok := columns["instance_id"]; !ok {
+		return nil, fmt.Errorf("the header must contain the instance_id column")
+	}
+
+	return func() (*v1.AppInstance, error) {
+		record, err := cr.Read()
+		if err == io.EOF {
+			return nil, io.EOF
+		} else if _, ok := err.(*csv.ParseError); ok {
+			return nil, &RowError{Err: err}
+		} else if err != nil {
+			return nil, err
+		}
+
+		get := func(column string) string {
+			if i, ok := columns[column]; ok && i < len(record) {
+				return record[i]
+			}
+			return ""
+		}
+
+		i := &v1.AppInstance{
+			InstanceId: get("instance_id"),
+			Token:      get("token"),
+			Ref:        get("ref"),
+			AppVersion: get("app_version"),
+			OsVersion:  get("os_version"),
+			SdkVersion: get("sdk_version"),
+			Timezone:   get("timezone"),
+		}
+
+		if p := get("platform"); p != "" {
+			v, ok := v1.Platform_value[strings.ToUpper(p)]
+			if !ok {
+				return nil, &RowError{Err: fmt.Errorf("unknown platform %q", p)}
+			}
+			i.Platform = v1.Platform(v)
+		}
+
+		if l := get("labels"); l != "" {
+			values, err := url.ParseQuery(l)
+			if err != nil {
+				return nil, &RowError{Err: fmt.Errorf("invalid labels %q: %v", l, err)}
+			}
+
+			i.Labels = map[string]string{}
+			for k := range values {
+				i.Labels[k] = values.Get(k)
+			}
+		}
+
+		if q := get("quiet_hours"); q != "" {
+			if i.QuietHours, err = parseQuietHours(q); err != nil {
+				return nil, &RowError{Err: fmt.Errorf("invalid quiet hours %q: %v", q, err)}
+			}
+		}
+
+		return i, nil
+	}, nil
+}
+
+type jsonlWriter struct {
+	w *bufio.Writer
+}
+
+func (w *jsonlWriter) Write(i *v1.AppInstance) error {
+	if err := (&jsonpb.Marshaler{}).Marshal(w.w, i); err != nil {
+		return err
+	}
+
+	return w.w.WriteByte('\n')
+}
+
+func (w *jsonlWriter) Flush() error {
+	return w.w.Flush()
+}
+
+type csvWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (w *csvWriter) Write(i *v1.AppInstance) error {
+	if !w.headerWritten {
+		if err := w.w.Write(csvColumns); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+
+	labels := url.Values{}
+	for k, v := range i.Labels {
+		labels.Set(k, v)
+	}
+
+	var platform string
+	if i.Platform != v1.Platform_PLATFORM_UNSPECIFIED {
+		platform = i.Platform.String()
+	}
+
+	return w.w.Write([]string{
+		i.InstanceId,
+		i.Token,
+		i.Ref,
+		labels.Encode(),
+		platform,
+		i.AppVersion,
+		i.OsVersion,
+		i.SdkVersion,
+		i.Timezone,
+		formatQuietHours(i.QuietHours),
+	})
+}
+
+func (w *csvWriter) Flush() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// quietHoursKeys are the keys of the quiet hours encoded in the CSV column
+var quietHoursKeys = map[string]bool{"start": true, "end": true, "action": true, "timezone": true}
+
+// parseQuietHours returns the quiet hours encoded by the formatQuietHours
+func parseQuietHours(encoded string) (*v1.QuietHours, error) {
+	values, err := url.ParseQuery(encoded)
+	if err != nil {
+		return nil, err
+	}
+	for k := range values {
+		if !quietHoursKeys[k] {
+			return nil, fmt.Errorf("unknown key %q", k)
+		}
+	}
+
+	q := &v1.QuietHours{
+		Start:    values.Get("start"),
+		End:      values.Get("end"),
+		Timezone: values.Get("timezone"),
+	}
+	if a := values.Get("action"); a != "" {
+		v, ok := v1.QuietHours_Action_value[strings.ToUpper(a)]
+		if !ok {
+			return nil, fmt.Errorf("unknown action %q", a)
+		}
+		q.Action = v1.QuietHours_Action(v)
+	}
+
+	return q, nil
+}
+
+// formatQuietHours returns the URL query encoded quiet hours, or an empty string if nil
+func formatQuietHours(q *v1.QuietHours) string {
+	if q == nil {
+		return ""
+	}
+
+	values := url.Values{}
+	values.Set("start", q.Start)
+	values.Set("end", q.End)
+	values.Set("action", q.Action.String())
+	if q.Timezone != "" {
+		values.Set("timezone", q.Timezone)
+	}
+
+	return values.Encode()
+}
@@ -0,0 +1,139 @@
+package companion
+
+import (
+	"bytes"
+	"errors"
+	"github.com/golang/protobuf/proto"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"io"
+	"strings"
+	"testing"
+)
+
+// readInstances returns all instances of the reader and the number of row errors
+func readInstances(t *testing.T, read InstanceReader) ([]*v1.AppInstance, int) {
+	t.Helper()
+
+	var instances []*v1.AppInstance
+	var rowErrors int
+	for {
+		i, err := read()
+		var rowErr *RowError
+		if err == io.EOF {
+			return instances, rowErrors
+		} else if errors.As(err, &rowErr) {
+			rowErrors++
+			continue
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		instances = append(instances, i)
+	}
+}
+
+func TestInstanceFormatRoundTrip(t *testing.T) {
+	instances := []*v1.AppInstance{
+		{InstanceId: "instance1"},
+		{
+			InstanceId: "instance2",
+			Token:      "t2",
+			Ref:        "u1",
+			Labels:     map[string]string{"lang": "en", "tier": "gold&silver"},
+			Platform:   v1.Platform_IOS,
+			AppVersion: "1.4.2",
+			OsVersion:  "14.1",
+			SdkVersion: "7.0.0",
+			Timezone:   "Europe/Prague",
+			QuietHours: &v1.QuietHours{Start: "22:00", End: "07:00", Action: v1.QuietHours_SILENT, Timezone: "UTC"},
+		},
+		{InstanceId: "instance3", QuietHours: &v1.QuietHours{Start: "23:30", End: "06:00"}},
+	}
+
+	for _, format := range []string{FormatJSONL, FormatCSV} {
+		t.Run(format, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			w, err := NewInstanceWriter(buf, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, i := range instances {
+				if err := w.Write(i); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := w.Flush(); err != nil {
+				t.Fatal(err)
+			}
+
+			r, err := NewInstanceReader(buf, format)
+			if err != nil {
+				t.Fatal(err)
+			}
+			read, rowErrors := readInstances(t, r)
+			if rowErrors != 0 || len(read) != len(instances) {
+				t.Fatalf("read %d instances and %d row errors, want %d instances", len(read), rowErrors, len(instances))
+			}
+			for n := range instances {
+				if !proto.Equal(read[n], instances[n]) {
+					t.Errorf("instance = %v, want %v", read[n], instances[n])
+				}
+			}
+		})
+	}
+}
+
+func TestCSVReader(t *testing.T) {
+	// columns may be in any order and missing
+	r, err := NewInstanceReader(strings.NewReader(strings.Join([]string{
+		"token,instance_id,platform,quiet_hours",
+		"t1,instance1,android,",
+		"t2,instance2,symbian,",
+		"t3,instance3,,start=22:00&end=07:00&mode=loud",
+		`t4,"instance4`,
+	}, "\n")), FormatCSV)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	instances, rowErrors := readInstances(t, r)
+	want := &v1.AppInstance{InstanceId: "instance1", Token: "t1", Platform: v1.Platform_ANDROID}
+	if len(instances) != 1 || !proto.Equal(instances[0], want) {
+		t.Errorf("instances = %v, want %v", instances, want)
+	}
+	if rowErrors != 3 {
+		t.Errorf("%d row errors, want the unknown platform, quiet hours key and the unterminated quote", rowErrors)
+	}
+
+	if _, err := NewInstanceReader(strings.NewReader("token,ref\n"), FormatCSV); err == nil {
+		t.Error("CSV without the instance_id column was read")
+	}
+}
+
+func TestJSONLReader(t *testing.T) {
+	r, err := NewInstanceReader(strings.NewReader(strings.Join([]string{
+		`{"instanceId": "instance1", "platform": "WEB"}`,
+		``,
+		`{"instanceId": 1}`,
+		`{"instance_id": "instance2", "labels": {"lang": "en"}}`,
+	}, "\n")), FormatJSONL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	instances, rowErrors := readInstances(t, r)
+	if len(instances) != 2 || instances[0].Platform != v1.Platform_WEB || instances[1].Labels["lang"] != "en" {
+		t.Errorf("instances = %v, want both valid lines", instances)
+	}
+	if rowErrors != 1 {
+		t.Errorf("%d row errors, want the invalid line", rowErrors)
+	}
+}
+
+func TestInstanceFormatUnknown(t *testing.T) {
+	if _, err := NewInstanceReader(strings.NewReader(""), "xml"); err == nil {
+		t.Error("reader of an unknown format was created")
+	}
+	if _, err := NewInstanceWriter(&bytes.Buffer{}, "xml"); err == nil {
+		t.Error("writer of an unknown format was created")
+	}
+}
@@ -0,0 +1,158 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"time"
+)
+
+// frequencyCap is the frequency cap of a template with the field of the history
+// it's counted by, i.e. the template or its category
+type frequencyCap struct {
+	*v1.FrequencyCap
+	period       time.Duration
+	field, value string
+}
+
+// capCounter holds the recent notifications of the refs during a request, so the
+// notifications of the request are counted as well
+type capCounter map[string][]time.Time
+
+// frequencyCapFor returns the frequency cap of the template or its category, or nil
+// if the template is not capped. Invalid caps are ignored
+func (s *Service) frequencyCapFor(templateID string) *frequencyCap {
+	t, err := s.template(templateID)
+	if err != nil {
+		return nil
+	}
+
+	c := &frequencyCap{FrequencyCap: t.FrequencyCap, field: "templateId", value: t.Id}
+	if c.FrequencyCap == nil && t.Category != "" {
+		c.FrequencyCap, c.field, c.value = s.config.GetCategoryFrequencyCaps()[t.Category], "category", t.Category
+	}
+	if c.FrequencyCap == nil {
+		return nil
+	}
+
+	period, err := time.ParseDuration(c.Period)
+	if err == nil && period <= 0 {
+		err = fmt.Errorf("period must be positive")
+	}
+	if err == nil {
+		err = c.Validate()
+	}
+	if err != nil {
+		s.Warn("Invalid frequency cap is ignored", zap.String("templateID", templateID), zap.Error(err))
+		return nil
+	}
+	c.period = period
+
+	return c
+}
+
+// allowNotification counts the notification to the ref against the cap. Notifications
+// over the cap return the time the cap allows them again
+func (s *Service) allowNotification(ctx context.Context, c *frequencyCap, counter capCounter, ref string) (bool, time.Time, error) {
+	if c == nil || ref == "" {
+		return true, time.Time{}, nil
+	}
+
+	now := time.Now()
+	key := ref + "/" + c.field + "/" + c.value
+
+	// only the latest max notifications within the period are needed
+	sent, ok := counter[key]
+	if !ok {
+		docs, err := s.FirestoreClient.Collection(s.CollectionPrefix+notificationsCollection).
+			Where("ref", "==", ref).
+			Where(c.field, "==", c.value).
+			Where("state", "==", v1.Notification_SENT).
+			Where("createdAt", ">", now.Add(-c.period)).
+			OrderBy("createdAt", firestore.Desc).
+			Limit(int(c.Max)).
+			Documents(ctx).
+			GetAll()
+		if err != nil {
+			return false, time.Time{}, err
+		}
+
+		for _, doc := range docs {
+			n := &v1.Notification{}
+			if err := doc.DataTo(n); err != nil {
+				return false, time.Time{}, err
+			}
+			t, _ := ptypes.Timestamp(n.CreatedAt)
+			sent = append(sent, t)
+		}
+	}
+
+	if len(sent) >= int(c.Max) {
+		counter[key] = sent
+		return false, sent[c.Max-1].Add(c.period), nil
+	}
+
+	counter[key] = append([]time.Time{now}, sent...)
+	return true, time.Time{}, nil
+}
+
+// capTokens applies the frequency cap of the policy to the refs of the tokens. Tokens of
+// refs over the cap are dropped or deferred, recorded as suppressed and returned
+func (s *Service) capTokens(ctx context.Context, p *sendPolicy, tokens []string, instances map[string]*v1.AppInstance) (map[string]bool, error) {
+	capped := map[string]bool{}
+	if p.cap == nil {
+		return capped, nil
+	}
+
+	var suppressed []*v1.Notification
+	for _, r := range groupByRef(tokens, instances) {
+		ok, until, err := s.allowNotification(ctx, p.cap, p.counter, r.ref)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			continue
+		}
+
+		reason := "frequency cap exceeded"
+		if p.cap.Action == v1.FrequencyCap_DEFER {
+			if err := p.reschedule(ctx, r.tokens, until); err != nil {
+				return nil, err
+			}
+			reason = fmt.Sprintf("frequency cap exceeded, deferred to %s", until.Format(time.RFC3339))
+		}
+
+		for _, t := range r.tokens {
+			capped[t] = true
+		}
+		suppressed = append(suppressed, p.notification(r.ref, r.tokens, instances, v1.Notification_SUPPRESSED, reason))
+
+		s.Info("Notification was suppressed",
+			zap.String("templateID", p.templateID),
+			zap.String("ref", r.ref),
+			zap.String("reason", reason),
+		)
+	}
+
+	s.recordNotifications(ctx, suppressed)
+	return capped, nil
+}
+
+// without returns the tokens that are not excluded
+func without(tokens []string, excluded map[string]bool) []string {
+	if len(excluded) == 0 {
+		return tokens
+	}
+
+	var kept []string
+	for _, t := range tokens {
+		if !excluded[t] {
+			kept = append(kept, t)
+		}
+	}
+
+	return kept
+}
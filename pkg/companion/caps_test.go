@@ -0,0 +1,148 @@
+package companion
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"testing"
+	"time"
+)
+
+// withFrequencyCaps configures the capped templates of the tests
+func withFrequencyCaps(s *Service) {
+	s.config = &v1.NotificationConfig{
+		Messages: []*v1.MessageTemplate{
+			{Id: "hello"},
+			{Id: "capped", FrequencyCap: &v1.FrequencyCap{Max: 1, Period: "24h", Action: v1.FrequencyCap_DEFER}},
+			{Id: "news", Category: "news"},
+			{Id: "broken", FrequencyCap: &v1.FrequencyCap{Max: 1, Period: "-1h"}},
+		},
+		CategoryFrequencyCaps: map[string]*v1.FrequencyCap{
+			"news": {Max: 2, Period: "1h"},
+		},
+	}
+}
+
+func TestFrequencyCapFor(t *testing.T) {
+	s, _ := newTestService(t)
+	withFrequencyCaps(s)
+
+	tests := []struct {
+		templateID   string
+		field, value string
+		period       time.Duration
+	}{
+		{templateID: "capped", field: "templateId", value: "capped", period: 24 * time.Hour},
+		{templateID: "news", field: "category", value: "news", period: time.Hour},
+		{templateID: "hello"},
+		{templateID: "broken"},
+		{templateID: "unknown"},
+	}
+
+	for _, tt := range tests {
+		c := s.frequencyCapFor(tt.templateID)
+		if tt.field == "" {
+			if c != nil {
+				t.Errorf("%s: frequencyCapFor = %+v, want none", tt.templateID, c)
+			}
+			continue
+		}
+
+		if c == nil || c.field != tt.field || c.value != tt.value || c.period != tt.period {
+			t.Errorf("%s: frequencyCapFor = %+v, want the %s cap of %s", tt.templateID, c, tt.field, tt.period)
+		}
+	}
+}
+
+func TestAllowNotification(t *testing.T) {
+	s, _ := newTestService(t)
+	withFrequencyCaps(s)
+	ctx := context.Background()
+	c := s.frequencyCapFor("news")
+
+	// the history holds an old and a recent notification of the category
+	now := time.Now()
+	p := &sendPolicy{templateID: "news", category: "news"}
+	var history []*v1.Notification
+	for _, age := range []time.Duration{2 * time.Hour, 10 * time.Minute} {
+		n := p.notification("u1", []string{"t1"}, nil, v1.Notification_SENT, "")
+		n.CreatedAt, _ = ptypes.TimestampProto(now.Add(-age))
+		history = append(history, n)
+	}
+	s.recordNotifications(ctx, history)
+
+	counter := capCounter{}
+	if ok, _, err := s.allowNotification(ctx, c, counter, "u1"); err != nil || !ok {
+		t.Fatalf("allowNotification = %v, %v, want the second notification allowed", ok, err)
+	}
+
+	// the notification of the request is counted as well
+	ok, until, err := s.allowNotification(ctx, c, counter, "u1")
+	if err != nil || ok {
+		t.Fatalf("allowNotification = %v, %v, want the third notification capped", ok, err)
+	}
+	if want := now.Add(50 * time.Minute); until.Sub(want) > time.Second || want.Sub(until) > time.Second {
+		t.Errorf("capped until %s, want %s when the recorded notification leaves the period", until, want)
+	}
+
+	if ok, _, err := s.allowNotification(ctx, c, capCounter{}, "u2"); err != nil || !ok {
+		t.Errorf("allowNotification of another ref = %v, %v, want allowed", ok, err)
+	}
+}
+
+func TestRescheduleDeferral(t *testing.T) {
+	at := time.Now().Add(time.Hour)
+	delivered := (&envelope{MessageID: "m1", Attributes: map[string]string{}}).newIncomingContext(context.Background())
+
+	tests := []struct {
+		name           string
+		ctx            context.Context
+		idempotencyKey string
+		want           int
+	}{
+		{name: "redelivered", ctx: delivered, want: 1},
+		{name: "idempotent", ctx: context.Background(), idempotencyKey: "k1", want: 1},
+		{name: "without keys", ctx: context.Background(), want: 2},
+	}
+
+	for _, tt := range tests {
+		s, f := newTestService(t)
+		withFrequencyCaps(s)
+
+		// the request is retried after deferring the tokens
+		p := s.newSendPolicy(tt.idempotencyKey, "capped", nil, nil, false, capCounter{})
+		for n := 0; n < 2; n++ {
+			if err := p.reschedule(tt.ctx, []string{"t1"}, at); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		if n := f.count(scheduledCollection); n != tt.want {
+			t.Errorf("%s: %d messages are scheduled, want %d", tt.name, n, tt.want)
+		}
+	}
+}
+
+func TestDeferralKey(t *testing.T) {
+	ctx := context.Background()
+	delivered := (&envelope{MessageID: "m1", Attributes: map[string]string{}}).newIncomingContext(ctx)
+	m := &v1.MulticastMessage{TemplateId: "capped", Tokens: []string{"t1"}}
+
+	if key, err := deferralKey(ctx, "", m); err != nil || key != "" {
+		t.Errorf("deferralKey without keys = %q, %v, want none", key, err)
+	}
+
+	key, err := deferralKey(delivered, "", m)
+	if err != nil || key == "" {
+		t.Fatalf("deferralKey = %q, %v, want the key of the delivery", key, err)
+	}
+
+	// messages deferred to other tokens or from other messages are keyed apart
+	other := &v1.MulticastMessage{TemplateId: "capped", Tokens: []string{"t2"}}
+	if k, _ := deferralKey(delivered, "", other); k == key {
+		t.Error("messages deferred to other tokens share the key")
+	}
+	if k, _ := deferralKey(delivered, "k1", m); k == key {
+		t.Error("messages of other idempotency keys share the key")
+	}
+}
@@ -0,0 +1,267 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"firebase.google.com/go/v4/messaging"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/duration"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+const (
+	// defaultTokenMaxAge follows the Firebase recommendation to remove tokens
+	// of instances not seen for about two months
+	defaultTokenMaxAge = 60 * 24 * time.Hour
+	// defaultInstanceMaxAge is the age after which instances are removed
+	defaultInstanceMaxAge = 270 * 24 * time.Hour
+)
+
+func (s *Service) CleanupInstances(ctx context.Context, r *v1.CleanupInstancesRequest) (*v1.CleanupReport, error) {
+	if err := r.Validate(); err != nil {
+		return &v1.CleanupReport{}, err
+	}
+
+	tokenMaxAge, err := maxAge(r.TokenMaxAge, s.TokenMaxAge, defaultTokenMaxAge)
+	if err != nil {
+		return &v1.CleanupReport{}, err
+	}
+	instanceMaxAge, err := maxAge(r.InstanceMaxAge, s.InstanceMaxAge, defaultInstanceMaxAge)
+	if err != nil {
+		return &v1.CleanupReport{}, err
+	}
+
+	now := time.Now()
+	tokenCutoff := now.Add(-tokenMaxAge)
+	instanceCutoff := now.Add(-instanceMaxAge)
+
+	report := &v1.CleanupReport{}
+
+	// instances are cleaned a page at a time, each write fails if the instance changed
+	// since it was scanned, e.g. it was registered again
+	err = s.scanInstances(ctx, s.FirestoreClient.Collection(s.CollectionPrefix+instancesCollection).Query, func(snaps []*firestore.DocumentSnapshot) error {
+		var stale, expired, valid []scannedInstance
+		for _, snap := range snaps {
+			i, err := instanceFromSnapshot(snap)
+			if err != nil {
+				return err
+			}
+			report.Scanned++
+
+			// instances without the last seen time were registered before it was tracked
+			// and are never considered stale
+			scanned := scannedInstance{AppInstance: i, updateTime: snap.UpdateTime}
+			seen := lastSeen(i)
+			switch {
+			case !seen.IsZero() && seen.Before(instanceCutoff):
+				stale = append(stale, scanned)
+			case i.Token == "":
+				continue
+			case !seen.IsZero() && seen.Before(tokenCutoff):
+				expired = append(expired, scanned)
+			default:
+				valid = append(valid, scanned)
+			}
+		}
+
+		var invalid []scannedInstance
+		if r.ValidateTokens {
+			var err error
+			if invalid, err = s.invalidTokens(ctx, valid); err != nil {
+				return err
+			}
+		}
+
+		if r.DryRun {
+			report.InstancesRemoved += int32(len(stale))
+			report.TokensExpired += int32(len(expired))
+			report.TokensInvalid += int32(len(invalid))
+			return nil
+		}
+
+		removed, err := s.writeUnchanged(ctx, stale, nil)
+		report.InstancesRemoved += int32(removed)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		resetToken := []firestore.Update{
+			{Path: "token", Value: ""},
+			{Path: "tokenUpdatedAt", Value: now},
+			{Path: "updatedAt", Value: now},
+		}
+
+		reset, err := s.writeUnchanged(ctx, expired, resetToken)
+		report.TokensExpired += int32(reset)
+		if err != nil {
+			return err
+		}
+
+		reset, err = s.writeUnchanged(ctx, invalid, resetToken)
+		report.TokensInvalid += int32(reset)
+		return err
+	})
+	if err != nil {
+		return &v1.CleanupReport{}, err
+	}
+
+	s.Info("Instances were cleaned",
+		zap.Bool("dryRun", r.DryRun),
+		zap.Int32("scanned", report.Scanned),
+		zap.Int32("tokensExpired", report.TokensExpired),
+		zap.Int32("tokensInvalid", report.TokensInvalid),
+		zap.Int32("instancesRemoved", report.InstancesRemoved),
+	)
+
+	return report, nil
+}
+
+// scannedInstance is an instance with the update time it was read at
+type scannedInstance struct {
+	*v1.AppInstance
+	updateTime time.Time
+}
+
+// scanInstances calls the scan with the pages of the instances of the query, ordered
+// by the query and their instance_id, so the whole collection is never held in memory
+func (s *Service) scanInstances(ctx context.Context, q firestore.Query, scan func([]*firestore.DocumentSnapshot) error) error {
+	q = q.OrderBy(firestore.DocumentID, firestore.Asc).Limit(maxBatchSize)
+
+	var last *firestore.DocumentSnapshot
+	for {
+		page := q
+		if last != nil {
+			page = q.StartAfter(last)
+		}
+
+		snaps, err := page.Documents(ctx).GetAll()
+		if err != nil {
+			return err
+		}
+		if len(snaps) == 0 {
+			return nil
+		}
+
+		if err := scan(snaps); err != nil {
+			return err
+		}
+		if len(snaps) < maxBatchSize {
+			return nil
+		}
+		last = snaps[len(snaps)-1]
+	}
+}
+
+// invalidTokens returns instances whose tokens are rejected by FCM in a dry run send
+func (s *Service) invalidTokens(ctx context.Context, instances []scannedInstance) ([]scannedInstance, error) {
+	var invalid []scannedInstance
+	for start := 0; start < len(instances); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(instances) {
+			end = len(instances)
+		}
+
+		msgs := make([]*messaging.Message, 0, end-start)
+		for _, i := range instances[start:end] {
+			msgs = append(msgs, &messaging.Message{Token: i.Token})
+		}
+
+		res, err := s.sendMessages(ctx, msgs, true)
+		if err != nil {
+			return nil, fcmError(err)
+		}
+
+		for i, sr := range res.Responses {
+			if !sr.Success && isInvalidToken(sr.Error) {
+				invalid = append(invalid, instances[start+i])
+			}
+		}
+	}
+
+	return invalid, nil
+}
+
+// writeUnchanged updates the instances in batches, or deletes them if the updates are nil.
+// Each write is conditioned on the instance not being changed since it was scanned.
+// Batches failing the condition are written one by one, skipping the changed instances.
+// It returns the number of written instances
+func (s *Service) writeUnchanged(ctx context.Context, instances []scannedInstance, updates []firestore.Update) (int, error) {
+	write := func(i scannedInstance) error {
+		doc, unchanged := s.instanceDoc(i.InstanceId), firestore.LastUpdateTime(i.updateTime)
+
+		var err error
+		if updates == nil {
+			_, err = doc.Delete(ctx, unchanged)
+		} else {
+			_, err = doc.Update(ctx, updates, unchanged)
+		}
+		return err
+	}
+
+	var written int
+	for start := 0; start < len(instances); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(instances) {
+			end = len(instances)
+		}
+
+		batch := s.FirestoreClient.Batch()
+		for _, i := range instances[start:end] {
+			doc, unchanged := s.instanceDoc(i.InstanceId), firestore.LastUpdateTime(i.updateTime)
+			if updates == nil {
+				batch.Delete(doc, unchanged)
+			} else {
+				batch.Update(doc, updates, unchanged)
+			}
+		}
+
+		_, err := batch.Commit(ctx)
+		if err == nil {
+			written += end - start
+			continue
+		} else if !isChanged(err) {
+			return written, err
+		}
+
+		for _, i := range instances[start:end] {
+			if err := write(i); isChanged(err) {
+				s.Debug("Changed instance skipped", zap.String("instanceID", i.InstanceId))
+				continue
+			} else if err != nil {
+				return written, err
+			}
+			written++
+		}
+	}
+
+	return written, nil
+}
+
+// isChanged returns true if the write failed because the document changed or was removed
+func isChanged(err error) bool {
+	return status.Code(err) == codes.FailedPrecondition || status.Code(err) == codes.NotFound
+}
+
+// maxAge returns the requested age, or the configured one, or the default
+func maxAge(requested *duration.Duration, configured, def time.Duration) (time.Duration, error) {
+	if requested != nil {
+		d, err := ptypes.Duration(requested)
+		if err != nil {
+			return 0, status.Errorf(codes.InvalidArgument, "invalid max age: %v", err)
+		}
+		if d > 0 {
+			return d, nil
+		}
+	}
+
+	if configured > 0 {
+		return configured, nil
+	}
+
+	return def, nil
+}
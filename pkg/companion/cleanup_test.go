@@ -0,0 +1,112 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCleanupInstances(t *testing.T) {
+	s, f := newTestService(t)
+	fcm := newFakeFCM(t, s)
+	ctx := context.Background()
+
+	day := 24 * time.Hour
+	instances := map[string]map[string]interface{}{
+		"stale":     {"token": "t1", "lastSeenAt": time.Now().Add(-300 * day)},
+		"expired":   {"token": "t2", "lastSeenAt": time.Now().Add(-90 * day)},
+		"invalid":   {"token": "t3", "lastSeenAt": time.Now()},
+		"valid":     {"token": "t4", "lastSeenAt": time.Now()},
+		"untracked": {"token": "t5"},
+		"tokenless": {"lastSeenAt": time.Now().Add(-90 * day)},
+	}
+	batch := s.FirestoreClient.Batch()
+	for id, data := range instances {
+		batch.Set(s.instanceDoc(id), data)
+	}
+	if _, err := batch.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+	fcm.errors["t3"] = "UNREGISTERED"
+
+	want := &v1.CleanupReport{Scanned: 6, TokensExpired: 1, TokensInvalid: 1, InstancesRemoved: 1}
+
+	report, err := s.CleanupInstances(ctx, &v1.CleanupInstancesRequest{ValidateTokens: true, DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(report, want) || f.count(instancesCollection) != len(instances) {
+		t.Fatalf("dry run = %v, want %v without changes", report, want)
+	}
+	if sent := fcm.tokens(); len(sent) != 0 {
+		t.Errorf("sent to %v, want the tokens only validated", sent)
+	}
+
+	report, err = s.CleanupInstances(ctx, &v1.CleanupInstancesRequest{ValidateTokens: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("CleanupInstances = %v, want %v", report, want)
+	}
+
+	tokens := map[string]string{}
+	docs, err := s.FirestoreClient.Collection(s.CollectionPrefix + instancesCollection).Documents(ctx).GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, doc := range docs {
+		i, err := instanceFromSnapshot(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tokens[i.InstanceId] = i.Token
+	}
+	wantTokens := map[string]string{"expired": "", "invalid": "", "valid": "t4", "untracked": "t5", "tokenless": ""}
+	if !reflect.DeepEqual(tokens, wantTokens) {
+		t.Errorf("instances = %v, want %v", tokens, wantTokens)
+	}
+}
+
+func TestWriteUnchanged(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	var scanned []scannedInstance
+	for _, id := range []string{"instance1", "instance2", "instance3"} {
+		res, err := s.instanceDoc(id).Set(ctx, map[string]interface{}{"token": "t-" + id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		scanned = append(scanned, scannedInstance{AppInstance: &v1.AppInstance{InstanceId: id}, updateTime: res.UpdateTime})
+	}
+
+	// the second instance is registered again and the third removed after the scan
+	if _, err := s.instanceDoc("instance2").Set(ctx, map[string]interface{}{"token": "new"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.instanceDoc("instance3").Delete(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	written, err := s.writeUnchanged(ctx, scanned, []firestore.Update{{Path: "token", Value: ""}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if written != 1 {
+		t.Errorf("written %d instances, want only the unchanged one", written)
+	}
+
+	for id, want := range map[string]string{"instance1": "", "instance2": "new"} {
+		snap, err := s.instanceDoc(id).Get(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token, _ := snap.DataAt("token"); token != want {
+			t.Errorf("token of %s = %q, want %q", id, token, want)
+		}
+	}
+}
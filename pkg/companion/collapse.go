@@ -0,0 +1,71 @@
+package companion
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"firebase.google.com/go/v4/messaging"
+)
+
+const (
+	// maxCollapseIDLength is the maximum length of the apns-collapse-id in bytes
+	maxCollapseIDLength = 64
+)
+
+// applyCollapseGroup sets the collapse group as the collapse key and the tag of all
+// platforms, so a newer notification of the group replaces the older one on every device.
+// Groups longer than the apns-collapse-id allows are hashed to be the same everywhere
+func applyCollapseGroup(msg *messaging.Message, group string) {
+	if group == "" {
+		return
+	}
+
+	sum := sha256.Sum256([]byte(group))
+	if len(group) > maxCollapseIDLength {
+		group = hex.EncodeToString(sum[:])
+	}
+
+	// tags are set only on notifications, a tag alone would turn data messages
+	// into notifications
+	hasNotification := msg.Notification != nil
+
+	if msg.Android == nil {
+		msg.Android = &messaging.AndroidConfig{}
+	}
+	msg.Android.CollapseKey = group
+	if msg.Android.Notification != nil || hasNotification {
+		if msg.Android.Notification == nil {
+			msg.Android.Notification = &messaging.AndroidNotification{}
+		}
+		msg.Android.Notification.Tag = group
+	}
+
+	if msg.APNS == nil {
+		msg.APNS = &messaging.APNSConfig{}
+	}
+	msg.APNS.Headers = withHeader(msg.APNS.Headers, "apns-collapse-id", group)
+
+	// the Web Push Topic replaces undelivered messages and allows only 32 base64url characters
+	if msg.Webpush == nil {
+		msg.Webpush = &messaging.WebpushConfig{}
+	}
+	msg.Webpush.Headers = withHeader(msg.Webpush.Headers, "Topic", base64.RawURLEncoding.EncodeToString(sum[:24]))
+	if msg.Webpush.Notification != nil || hasNotification {
+		if msg.Webpush.Notification == nil {
+			msg.Webpush.Notification = &messaging.WebpushNotification{}
+		}
+		msg.Webpush.Notification.Tag = group
+	}
+}
+
+// withHeader returns a copy of the headers with the header set
+func withHeader(headers map[string]string, key, value string) map[string]string {
+	copied := map[string]string{key: value}
+	for k, v := range headers {
+		if k != key {
+			copied[k] = v
+		}
+	}
+
+	return copied
+}
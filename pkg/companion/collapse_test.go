@@ -0,0 +1,71 @@
+package companion
+
+import (
+	"firebase.google.com/go/v4/messaging"
+	"strings"
+	"testing"
+)
+
+func TestApplyCollapseGroup(t *testing.T) {
+	msg := &messaging.Message{
+		Notification: &messaging.Notification{Title: "New message"},
+		APNS:         &messaging.APNSConfig{Headers: map[string]string{"apns-priority": "10"}},
+	}
+	headers := msg.APNS.Headers
+
+	applyCollapseGroup(msg, "chat-1")
+
+	if msg.Android.CollapseKey != "chat-1" || msg.Android.Notification.Tag != "chat-1" {
+		t.Errorf("android = %+v, want the collapse key and tag chat-1", msg.Android)
+	}
+	if msg.APNS.Headers["apns-collapse-id"] != "chat-1" || msg.APNS.Headers["apns-priority"] != "10" {
+		t.Errorf("apns headers = %v, want the collapse id and the priority", msg.APNS.Headers)
+	}
+	if _, ok := headers["apns-collapse-id"]; ok {
+		t.Error("shared apns headers were modified")
+	}
+	if topic := msg.Webpush.Headers["Topic"]; len(topic) != 32 {
+		t.Errorf("webpush topic = %q, want 32 characters", topic)
+	}
+	if msg.Webpush.Notification.Tag != "chat-1" {
+		t.Errorf("webpush tag = %q, want chat-1", msg.Webpush.Notification.Tag)
+	}
+}
+
+func TestApplyCollapseGroupData(t *testing.T) {
+	msg := &messaging.Message{Data: map[string]string{"k": "v"}}
+
+	applyCollapseGroup(msg, "chat-1")
+
+	if msg.Android.CollapseKey != "chat-1" {
+		t.Errorf("android collapse key = %q, want chat-1", msg.Android.CollapseKey)
+	}
+	if msg.Android.Notification != nil || msg.Webpush.Notification != nil {
+		t.Error("data message was turned into a notification")
+	}
+}
+
+func TestApplyCollapseGroupLong(t *testing.T) {
+	msg := &messaging.Message{}
+	group := strings.Repeat("g", maxCollapseIDLength+1)
+
+	applyCollapseGroup(msg, group)
+
+	id := msg.APNS.Headers["apns-collapse-id"]
+	if len(id) != maxCollapseIDLength {
+		t.Fatalf("apns collapse id = %q, want %d characters", id, maxCollapseIDLength)
+	}
+	if msg.Android.CollapseKey != id {
+		t.Errorf("android collapse key = %q, want %q", msg.Android.CollapseKey, id)
+	}
+}
+
+func TestApplyCollapseGroupEmpty(t *testing.T) {
+	msg := &messaging.Message{}
+
+	applyCollapseGroup(msg, "")
+
+	if msg.Android != nil || msg.APNS != nil || msg.Webpush != nil {
+		t.Errorf("message = %+v, want unchanged", msg)
+	}
+}
@@ -36,7 +36,7 @@ func New(ctx context.Context, projectID string, logger *zap.Logger, collectionPr
 		return nil, fmt.Errorf("error fetching configuration: %v", err)
 	}
 
-	fmt.Println(config.Messages)
+	logger.Debug("Configuration was fetched", zap.Int("templates", len(config.Messages)))
 
 	notificationSvc := &Service{
 		Logger:           logger,
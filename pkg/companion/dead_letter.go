@@ -0,0 +1,218 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	deadLettersCollection = "fcm-companion-dead-letters"
+)
+
+// deadLetter stores permanently failed requests delivered by Pub/Sub as dead letters
+// and returns nil, so the message is acknowledged and not retried forever.
+// Retryable errors are returned as they are
+func (s *Service) deadLetter(ctx context.Context, env *envelope, method string, r proto.Message, err error) error {
+	if err == nil || isRetryable(err) {
+		return err
+	}
+
+	payload, mErr := (&jsonpb.Marshaler{}).MarshalToString(r)
+	if mErr != nil {
+		return err
+	}
+
+	refs, tokens := requestRecipients(r)
+	dlErr := s.storeDeadLetter(ctx, &v1.DeadLetter{
+		Id:           deadLetterID(env),
+		Method:       method,
+		Payload:      payload,
+		Reason:       err.Error(),
+		Subscription: env.Subscription,
+		Tenant:       env.Tenant(),
+		Refs:         refs,
+		Tokens:       tokens,
+	})
+	if dlErr != nil {
+		// the message must be redelivered, so it's not lost
+		return status.Errorf(codes.Unavailable, "cannot store dead letter: %v", dlErr)
+	}
+
+	return nil
+}
+
+// deadLetterPayload stores the payload of the message that failed permanently before
+// it was decoded or dispatched as a dead letter
+func (s *Service) deadLetterPayload(ctx context.Context, env *envelope, method string, payload []byte, err error) error {
+	dl := &v1.DeadLetter{
+		Id:           deadLetterID(env),
+		Method:       method,
+		Payload:      string(payload),
+		Reason:       err.Error(),
+		Subscription: env.Subscription,
+		Tenant:       env.Tenant(),
+	}
+	if r := sendRequest(method); r != nil && unmarshalBody(payload, r) == nil {
+		dl.Refs, dl.Tokens = requestRecipients(r)
+	}
+
+	return s.storeDeadLetter(ctx, dl)
+}
+
+// deadLetterID returns the id of the dead letter of the message. Messages are scoped by
+// the tenant the same way as they are deduplicated, an empty id is generated on store
+func deadLetterID(env *envelope) string {
+	key := env.DeliveryKey()
+	if key == "" {
+		return ""
+	}
+
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// storeDeadLetter saves the dead letter under its id if present, or a generated one
+func (s *Service) storeDeadLetter(ctx context.Context, dl *v1.DeadLetter) error {
+	col := s.FirestoreClient.Collection(s.CollectionPrefix + deadLettersCollection)
+
+	doc := col.NewDoc()
+	if dl.Id != "" {
+		doc = col.Doc(dl.Id)
+	}
+
+	dl.CreatedAt = ptypes.TimestampNow()
+	if _, err := doc.Set(ctx, dl); err != nil {
+		return err
+	}
+
+	s.Warn("Request was dead lettered",
+		zap.String("id", doc.ID),
+		zap.String("method", dl.Method),
+		zap.String("tenant", dl.Tenant),
+		zap.String("reason", dl.Reason),
+	)
+
+	return nil
+}
+
+func (s *Service) ListDeadLetters(ctx context.Context, r *v1.ListDeadLettersRequest) (*v1.DeadLetterList, error) {
+	if err := r.Validate(); err != nil {
+		return &v1.DeadLetterList{}, err
+	}
+
+	col := s.FirestoreClient.Collection(s.CollectionPrefix + deadLettersCollection)
+	q := col.OrderBy("createdAt", firestore.Desc)
+
+	// the page token is the id of the last dead letter of the previous page
+	if r.PageToken != "" {
+		last, err := col.Doc(r.PageToken).Get(ctx)
+		if err != nil {
+			return &v1.DeadLetterList{}, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		q = q.StartAfter(last)
+	}
+
+	pageSize := pageSize(r.PageSize)
+	docs, err := q.Limit(pageSize).Documents(ctx).GetAll()
+	if err != nil {
+		return &v1.DeadLetterList{}, err
+	}
+
+	list := &v1.DeadLetterList{}
+	for _, doc := range docs {
+		dl := &v1.DeadLetter{}
+		if err := doc.DataTo(dl); err != nil {
+			return &v1.DeadLetterList{}, err
+		}
+		dl.Id = doc.Ref.ID
+
+		list.DeadLetters = append(list.DeadLetters, dl)
+	}
+
+	if len(docs) == pageSize {
+		list.NextPageToken = docs[len(docs)-1].Ref.ID
+	}
+
+	return list, nil
+}
+
+func (s *Service) ReplayDeadLetter(ctx context.Context, r *v1.ReplayDeadLetterRequest) (*empty.Empty, error) {
+	if err := r.Validate(); err != nil {
+		return &empty.Empty{}, err
+	}
+
+	if strings.Contains(r.Id, "/") {
+		return &empty.Empty{}, status.Errorf(codes.InvalidArgument, "invalid id %q", r.Id)
+	}
+
+	doc := s.FirestoreClient.Collection(s.CollectionPrefix + deadLettersCollection).Doc(r.Id)
+
+	snap, err := doc.Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return &empty.Empty{}, status.Errorf(codes.NotFound, "dead letter %q not found", r.Id)
+	} else if err != nil {
+		return &empty.Empty{}, err
+	}
+
+	dl := &v1.DeadLetter{}
+	if err := snap.DataTo(dl); err != nil {
+		return &empty.Empty{}, err
+	}
+
+	// the failed idempotency keys would return the stored error instead of sending
+	if r := sendRequest(dl.Method); r != nil && unmarshalBody([]byte(dl.Payload), r) == nil {
+		if err := s.releaseFailedKeys(ctx, dl.Method, r); err != nil {
+			return &empty.Empty{}, err
+		}
+	}
+
+	if err := s.dispatch(ctx, dl.Method, []byte(dl.Payload)); err != nil {
+		return &empty.Empty{}, err
+	}
+
+	_, err = doc.Delete(ctx)
+	return &empty.Empty{}, err
+}
+
+// releaseFailedKeys releases the idempotency keys of the request that were completed with
+// a failure, so the replayed request is sent again. Keys of the sent messages are kept,
+// so the messages are not sent twice
+func (s *Service) releaseFailedKeys(ctx context.Context, method string, r proto.Message) error {
+	for _, key := range requestIdempotencyKeys(method, r) {
+		doc := s.deliveryDoc(key)
+
+		snap, err := doc.Get(ctx)
+		if status.Code(err) == codes.NotFound {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		d := &delivery{}
+		if err := snap.DataTo(d); err != nil {
+			return err
+		}
+		if d.State != deliveryDone || d.result() == nil {
+			continue
+		}
+
+		// keys changed in the meantime were claimed by another request
+		_, err = doc.Delete(ctx, firestore.LastUpdateTime(snap.UpdateTime))
+		if err != nil && status.Code(err) != codes.FailedPrecondition && status.Code(err) != codes.NotFound {
+			return err
+		}
+	}
+
+	return nil
+}
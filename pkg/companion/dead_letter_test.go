@@ -0,0 +1,96 @@
+package companion
+
+import (
+	"context"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+)
+
+func TestReplayDeadLetter(t *testing.T) {
+	s, f := newTestService(t)
+	fcm := newFakeFCM(t, s)
+	ctx := context.Background()
+
+	// the message is rejected and dead lettered, its idempotency key stores the failure
+	fcm.errors["t1"] = "INVALID_ARGUMENT"
+	env := &envelope{MessageID: "m1", Attributes: map[string]string{}}
+	body := []byte(`{"message": {"templateId": "hello", "token": "t1", "idempotencyKey": "k1"}}`)
+	if err := s.dispatch(env.newIncomingContext(ctx), MethodSend, body); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := s.ListDeadLetters(ctx, &v1.ListDeadLettersRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.DeadLetters) != 1 || list.DeadLetters[0].Id != deadLetterID(env) {
+		t.Fatalf("dead letters = %v, want the message", list.DeadLetters)
+	}
+	dl := list.DeadLetters[0]
+	if dl.Method != MethodSend || !reflect.DeepEqual(dl.Tokens, []string{"t1"}) {
+		t.Errorf("dead letter = %v, want the Send to t1", dl)
+	}
+
+	// once fixed, the replay sends the message despite the stored failure
+	delete(fcm.errors, "t1")
+	if _, err := s.ReplayDeadLetter(ctx, &v1.ReplayDeadLetterRequest{Id: dl.Id}); err != nil {
+		t.Fatal(err)
+	}
+	if tokens := fcm.tokens(); !reflect.DeepEqual(tokens, []string{"t1"}) {
+		t.Errorf("sent to %v, want t1", tokens)
+	}
+	if n := f.count(deadLettersCollection); n != 0 {
+		t.Errorf("%d dead letters are stored, want the replayed one removed", n)
+	}
+
+	// the replayed result is stored under the key
+	if _, err := s.Send(ctx, &v1.SendRequest{Message: &v1.Message{TemplateId: "hello", Token: "t1", IdempotencyKey: "k1"}}); err != nil {
+		t.Fatal(err)
+	}
+	if tokens := fcm.tokens(); len(tokens) != 1 {
+		t.Errorf("sent to %v, want the duplicate skipped", tokens)
+	}
+
+	if _, err := s.ReplayDeadLetter(ctx, &v1.ReplayDeadLetterRequest{Id: dl.Id}); status.Code(err) != codes.NotFound {
+		t.Errorf("second replay = %v, want NotFound", err)
+	}
+	if _, err := s.ReplayDeadLetter(ctx, &v1.ReplayDeadLetterRequest{Id: "a/b"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("replay of a path = %v, want InvalidArgument", err)
+	}
+}
+
+func TestReleaseFailedKeys(t *testing.T) {
+	s, f := newTestService(t)
+	ctx := context.Background()
+
+	r := &v1.SendAllRequest{
+		IdempotencyKey: "request",
+		Messages:       []*v1.Message{{IdempotencyKey: "sent"}, {IdempotencyKey: "failed"}, {}},
+	}
+	for key, result := range map[string]error{
+		idempotencyKey(MethodSendAll, idempotencyRequest, "request"): status.Error(codes.InvalidArgument, "invalid"),
+		idempotencyKey(MethodSendAll, idempotencyMessage, "sent"):    nil,
+		idempotencyKey(MethodSendAll, idempotencyMessage, "failed"):  status.Error(codes.NotFound, "unregistered"),
+	} {
+		if _, _, err := s.claim(ctx, key, deliveryTTL); err != nil {
+			t.Fatal(err)
+		}
+		if err := s.complete(ctx, key, result); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := s.releaseFailedKeys(ctx, MethodSendAll, r); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := f.count(deliveriesCollection); n != 1 {
+		t.Errorf("%d keys are stored, want only the sent one", n)
+	}
+	if _, err := s.deliveryDoc(idempotencyKey(MethodSendAll, idempotencyMessage, "sent")).Get(ctx); err != nil {
+		t.Errorf("key of the sent message = %v, want kept", err)
+	}
+}
@@ -0,0 +1,268 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/golang/protobuf/proto"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+const (
+	deliveriesCollection = "fcm-companion-deliveries"
+
+	// deliveryTTL is how long processed Pub/Sub messages are remembered. Pub/Sub
+	// doesn't redeliver messages older than 7 days. The expiresAt field can be used
+	// as a Firestore TTL policy to remove expired deliveries
+	deliveryTTL = 7 * 24 * time.Hour
+	// defaultIdempotencyTTL is how long idempotency keys of the requests are remembered
+	// if the Service doesn't configure the IdempotencyTTL
+	defaultIdempotencyTTL = 24 * time.Hour
+	// deliveryLease is how long a pending delivery blocks other replicas before
+	// it's considered abandoned (e.g. the replica crashed)
+	deliveryLease = 2 * time.Minute
+
+	// idempotencyRequest and idempotencyMessage are the levels of the idempotency keys
+	idempotencyRequest = "request"
+	idempotencyMessage = "message"
+
+	deliveryPending = "pending"
+	deliveryDone    = "done"
+)
+
+// delivery is the stored state of a processed Pub/Sub message or a request
+// with an idempotency key
+type delivery struct {
+	Key       string    `firestore:"key"`
+	State     string    `firestore:"state"`
+	UpdatedAt time.Time `firestore:"updatedAt"`
+	ExpiresAt time.Time `firestore:"expiresAt"`
+
+	// Code and Message are the status of the original result
+	Code    int32  `firestore:"code"`
+	Message string `firestore:"message,omitempty"`
+}
+
+// result returns the original result of the delivery
+func (d *delivery) result() error {
+	if codes.Code(d.Code) == codes.OK {
+		return nil
+	}
+
+	return status.Error(codes.Code(d.Code), d.Message)
+}
+
+// deliveryDoc returns the document of the delivery. Keys are hashed as they
+// may contain characters not allowed in document IDs
+func (s *Service) deliveryDoc(key string) *firestore.DocumentRef {
+	sum := sha256.Sum256([]byte(key))
+	return s.FirestoreClient.Doc(s.CollectionPrefix + deliveriesCollection + "/" + hex.EncodeToString(sum[:]))
+}
+
+// idempotencyTTL returns the configured IdempotencyTTL or the default
+func (s *Service) idempotencyTTL() time.Duration {
+	if s.IdempotencyTTL > 0 {
+		return s.IdempotencyTTL
+	}
+
+	return defaultIdempotencyTTL
+}
+
+// deliver runs the send of the request. Requests delivered by Pub/Sub are deduplicated
+// by their delivery key, so redelivered messages are never sent twice, and are
+// dead lettered on permanent failures
+func (s *Service) deliver(ctx context.Context, method string, r proto.Message, send func(ctx context.Context) error) error {
+	env, ok := envelopeFromContext(ctx)
+	if !ok {
+		return send(ctx)
+	}
+
+	key := env.DeliveryKey()
+	if key == "" {
+		return s.deadLetter(ctx, env, method, r, send(ctx))
+	}
+
+	claimed, _, err := s.claim(ctx, key, deliveryTTL)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		s.Info("Duplicate delivery skipped",
+			zap.String("method", method),
+			zap.String("messageID", env.MessageID),
+			zap.String("key", key),
+		)
+		return nil
+	}
+	defer s.releaseOnPanic(ctx, key)
+
+	err = send(ctx)
+	s.settle(ctx, key, err)
+
+	return s.deadLetter(ctx, env, method, r, err)
+}
+
+// idempotencyKey returns the stored key of the idempotency key sent to the method at
+// the level, so keys of different methods, and of requests and their messages, never collide
+func idempotencyKey(method, level, key string) string {
+	return "idempotency:" + method + ":" + level + ":" + key
+}
+
+// requestIdempotencyKeys returns the stored idempotency keys of the request of the method
+func requestIdempotencyKeys(method string, r proto.Message) []string {
+	var keys []string
+	add := func(level, key string) {
+		if key != "" {
+			keys = append(keys, idempotencyKey(method, level, key))
+		}
+	}
+
+	switch r := r.(type) {
+	case *v1.SendRequest:
+		add(idempotencyMessage, r.GetMessage().GetIdempotencyKey())
+	case *v1.SendAllRequest:
+		add(idempotencyRequest, r.IdempotencyKey)
+		for _, m := range r.Messages {
+			add(idempotencyMessage, m.IdempotencyKey)
+		}
+	case *v1.SendMulticastRequest:
+		add(idempotencyMessage, r.GetMessage().GetIdempotencyKey())
+	}
+
+	return keys
+}
+
+// idempotent runs the send once for the idempotency key of the method and the level.
+// Requests with an already seen key return the original result instead. Empty keys
+// are not deduplicated
+func (s *Service) idempotent(ctx context.Context, method, level, sentKey string, send func() error) error {
+	if sentKey == "" {
+		return statusError(send())
+	}
+
+	key := idempotencyKey(method, level, sentKey)
+	claimed, original, err := s.claim(ctx, key, s.idempotencyTTL())
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		s.Info("Duplicate request skipped", zap.String("key", key))
+		return original.result()
+	}
+	defer s.releaseOnPanic(ctx, key)
+
+	// duplicates return the stored status, so the original result has the same code
+	err = statusError(send())
+	s.settle(ctx, key, err)
+
+	return err
+}
+
+// settle completes the claimed key with the result, or releases it on retryable
+// errors, so the retried request is processed again
+func (s *Service) settle(ctx context.Context, key string, err error) {
+	if err != nil && isRetryable(err) {
+		if rErr := s.release(ctx, key); rErr != nil {
+			s.Error("Cannot release delivery", zap.String("key", key), zap.Error(rErr))
+		}
+		return
+	}
+
+	if cErr := s.complete(ctx, key, err); cErr != nil {
+		s.Error("Cannot complete delivery", zap.String("key", key), zap.Error(cErr))
+	}
+}
+
+// releaseOnPanic releases the claimed key if the processing panics and panics again, so
+// the key doesn't block the request once it's fixed and replayed
+func (s *Service) releaseOnPanic(ctx context.Context, key string) {
+	if p := recover(); p != nil {
+		if err := s.release(ctx, key); err != nil {
+			s.Error("Cannot release delivery", zap.String("key", key), zap.Error(err))
+		}
+		panic(p)
+	}
+}
+
+// claim marks the key as pending for the ttl. It returns false and the original delivery
+// if the key was already processed, and an Aborted error if it's being processed by another
+// caller or replica
+func (s *Service) claim(ctx context.Context, key string, ttl time.Duration) (bool, *delivery, error) {
+	doc := s.deliveryDoc(key)
+	now := time.Now()
+
+	_, err := doc.Create(ctx, &delivery{
+		Key:       key,
+		State:     deliveryPending,
+		UpdatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	})
+	if err == nil {
+		return true, nil, nil
+	} else if status.Code(err) != codes.AlreadyExists {
+		return false, nil, err
+	}
+
+	snap, err := doc.Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		// released in the meantime, let the caller retry
+		return false, nil, status.Error(codes.Aborted, "delivery was released concurrently")
+	} else if err != nil {
+		return false, nil, err
+	}
+
+	d := &delivery{}
+	if err := snap.DataTo(d); err != nil {
+		return false, nil, err
+	}
+
+	// expired keys are processed again, even if not yet removed by the TTL policy
+	expired := now.After(d.ExpiresAt)
+
+	if d.State == deliveryDone && !expired {
+		return false, d, nil
+	}
+
+	if d.State == deliveryPending && !expired && now.Sub(d.UpdatedAt) < deliveryLease {
+		return false, nil, status.Error(codes.Aborted, "delivery is being processed")
+	}
+
+	// the delivery was abandoned or expired, take it over unless someone was faster
+	_, err = doc.Update(ctx, []firestore.Update{
+		{Path: "state", Value: deliveryPending},
+		{Path: "updatedAt", Value: now},
+		{Path: "expiresAt", Value: now.Add(ttl)},
+	}, firestore.LastUpdateTime(snap.UpdateTime))
+	if status.Code(err) == codes.FailedPrecondition {
+		return false, nil, status.Error(codes.Aborted, "delivery was claimed concurrently")
+	} else if err != nil {
+		return false, nil, err
+	}
+
+	return true, nil, nil
+}
+
+// complete marks the key as processed with the result of the processing. Errors
+// without a status are stored as Unknown
+func (s *Service) complete(ctx context.Context, key string, result error) error {
+	st := status.Convert(statusError(result))
+
+	_, err := s.deliveryDoc(key).Update(ctx, []firestore.Update{
+		{Path: "state", Value: deliveryDone},
+		{Path: "updatedAt", Value: time.Now()},
+		{Path: "code", Value: int32(st.Code())},
+		{Path: "message", Value: st.Message()},
+	})
+	return err
+}
+
+// release removes the pending key, so it can be processed again
+func (s *Service) release(ctx context.Context, key string) error {
+	_, err := s.deliveryDoc(key).Delete(ctx)
+	return err
+}
@@ -0,0 +1,136 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+	"time"
+)
+
+func TestIdempotentDuplicateCode(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	// the message has no template, so its validation fails
+	r := &v1.SendRequest{Message: &v1.Message{Token: "t1", IdempotencyKey: "k1"}}
+
+	_, err := s.Send(ctx, r)
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("Send = %v, want InvalidArgument", err)
+	}
+
+	_, dup := s.Send(ctx, r)
+	if status.Code(dup) != codes.InvalidArgument || status.Convert(dup).Message() != status.Convert(err).Message() {
+		t.Errorf("duplicate Send = %v, want the original %v", dup, err)
+	}
+}
+
+func TestIdempotent(t *testing.T) {
+	s, f := newTestService(t)
+	ctx := context.Background()
+
+	sends := 0
+	send := func(err error) func() error {
+		return func() error {
+			sends++
+			return err
+		}
+	}
+
+	// retryable failures release the key, so the retry is sent
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+	if err := s.idempotent(ctx, MethodSend, idempotencyMessage, "k1", send(unavailable)); err != unavailable {
+		t.Fatalf("idempotent = %v, want the unavailable error", err)
+	}
+	if n := f.count(deliveriesCollection); n != 0 {
+		t.Errorf("%d keys are stored, want the key released", n)
+	}
+
+	if err := s.idempotent(ctx, MethodSend, idempotencyMessage, "k1", send(nil)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.idempotent(ctx, MethodSend, idempotencyMessage, "k1", send(nil)); err != nil {
+		t.Fatal(err)
+	}
+	if sends != 2 {
+		t.Errorf("sent %d times, want the duplicate skipped", sends)
+	}
+
+	// keys are scoped by the method and the level
+	if err := s.idempotent(ctx, MethodSendAll, idempotencyMessage, "k1", send(nil)); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.idempotent(ctx, MethodSend, idempotencyRequest, "k1", send(nil)); err != nil {
+		t.Fatal(err)
+	}
+	if sends != 4 {
+		t.Errorf("sent %d times, want the keys of other methods and levels sent", sends)
+	}
+}
+
+func TestClaim(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	claimed, _, err := s.claim(ctx, "k1", time.Hour)
+	if err != nil || !claimed {
+		t.Fatalf("claim = %v, %v, want claimed", claimed, err)
+	}
+
+	// pending keys block other callers
+	if _, _, err := s.claim(ctx, "k1", time.Hour); status.Code(err) != codes.Aborted {
+		t.Errorf("claim of a pending key = %v, want Aborted", err)
+	}
+
+	if err := s.complete(ctx, "k1", status.Error(codes.NotFound, "unregistered")); err != nil {
+		t.Fatal(err)
+	}
+	claimed, d, err := s.claim(ctx, "k1", time.Hour)
+	if err != nil || claimed || status.Code(d.result()) != codes.NotFound {
+		t.Errorf("claim of a done key = %v, %v, %v, want the stored NotFound", claimed, d, err)
+	}
+
+	// abandoned keys are taken over
+	if _, err := s.deliveryDoc("k1").Update(ctx, []firestore.Update{
+		{Path: "state", Value: deliveryPending},
+		{Path: "updatedAt", Value: time.Now().Add(-2 * deliveryLease)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if claimed, _, err := s.claim(ctx, "k1", time.Hour); err != nil || !claimed {
+		t.Errorf("claim of an abandoned key = %v, %v, want claimed", claimed, err)
+	}
+
+	// expired keys are processed again
+	if err := s.complete(ctx, "k1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.deliveryDoc("k1").Update(ctx, []firestore.Update{
+		{Path: "expiresAt", Value: time.Now().Add(-time.Minute)},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if claimed, _, err := s.claim(ctx, "k1", time.Hour); err != nil || !claimed {
+		t.Errorf("claim of an expired key = %v, %v, want claimed", claimed, err)
+	}
+}
+
+func TestCompleteValidationError(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	if _, _, err := s.claim(ctx, "k1", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.complete(ctx, "k1", (&v1.SendRequest{}).Validate()); err != nil {
+		t.Fatal(err)
+	}
+
+	_, d, err := s.claim(ctx, "k1", time.Hour)
+	if err != nil || codes.Code(d.Code) != codes.InvalidArgument {
+		t.Errorf("stored delivery = %+v, %v, want InvalidArgument", d, err)
+	}
+}
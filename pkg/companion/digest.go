@@ -0,0 +1,304 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	digestsCollection = "fcm-companion-digests"
+
+	// defaultDigestItems is the number of the listed items of digests without max_items
+	defaultDigestItems = 10
+	// defaultDigestSeparator joins the items of digests without a separator
+	defaultDigestSeparator = "\n"
+
+	// methodSendDigest is the method of the scheduled digests. The scheduler sends them
+	// as Send requests that are not digested again
+	methodSendDigest = "SendDigest"
+)
+
+// digestBucket holds the pending messages of a template sent to a ref until the window
+// of the digest ends
+type digestBucket struct {
+	Ref        string `firestore:"ref"`
+	TemplateID string `firestore:"templateId"`
+	Count      int    `firestore:"count"`
+	// Items are the template data of the first listed messages
+	Items []map[string]string `firestore:"items"`
+	// Data is the data of the last message
+	Data      map[string]string `firestore:"data,omitempty"`
+	CreatedAt time.Time         `firestore:"createdAt"`
+	DueAt     time.Time         `firestore:"dueAt"`
+}
+
+// digestConfig is the digest of a template with the parsed window
+type digestConfig struct {
+	*v1.Digest
+	window time.Duration
+}
+
+// digestedKey marks the context of the sends of the flushed digests
+type digestedKey struct{}
+
+// isDigest returns true if the context sends a flushed digest
+func isDigest(ctx context.Context) bool {
+	digest, _ := ctx.Value(digestedKey{}).(bool)
+	return digest
+}
+
+// digestFor returns the digest of the message, or nil if the message is sent right away.
+// Only messages sent to a ref are digested, flushed digests never. Invalid digests
+// are ignored
+func (s *Service) digestFor(ctx context.Context, m *v1.Message) *digestConfig {
+	if m.Ref == "" || m.Critical || isDigest(ctx) {
+		return nil
+	}
+
+	t, err := s.template(m.TemplateId)
+	if err != nil || t.Digest == nil {
+		return nil
+	}
+
+	d := &digestConfig{Digest: t.Digest}
+	d.window, err = time.ParseDuration(d.Window)
+	if err == nil && d.window <= 0 {
+		err = fmt.Errorf("window must be positive")
+	}
+	if err == nil {
+		err = d.Validate()
+	}
+	if err == nil {
+		var digestTemplate *v1.MessageTemplate
+		if digestTemplate, err = s.template(d.TemplateId); err == nil && digestTemplate.Digest != nil {
+			err = fmt.Errorf("digest template %q can't be digested", d.TemplateId)
+		}
+	}
+	if err != nil {
+		s.Warn("Invalid digest is ignored", zap.String("templateID", m.TemplateId), zap.Error(err))
+		return nil
+	}
+
+	return d
+}
+
+// digestDoc returns the bucket of the ref and the template
+func (s *Service) digestDoc(ref, templateID string) *firestore.DocumentRef {
+	sum := sha256.Sum256([]byte(ref + "\x00" + templateID))
+	return s.FirestoreClient.Collection(s.CollectionPrefix + digestsCollection).Doc(hex.EncodeToString(sum[:20]))
+}
+
+// addToDigest adds the message to the pending bucket of its ref and template. The bucket
+// is created by the first message and sent by the scheduler once its window ends.
+// Retried messages are deduplicated by the idempotency keys claimed by the callers
+func (s *Service) addToDigest(ctx context.Context, m *v1.Message, d *digestConfig) error {
+	doc := s.digestDoc(m.Ref, m.TemplateId)
+
+	maxItems := int(d.MaxItems)
+	if maxItems == 0 {
+		maxItems = defaultDigestItems
+	}
+
+	// buckets with all items listed only count the messages, which doesn't need
+	// a transaction contending with the other messages of the bucket
+	counted, err := s.countInDigest(ctx, doc, m, maxItems)
+	if err != nil || counted {
+		return err
+	}
+
+	return s.FirestoreClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		now := time.Now()
+		b := &digestBucket{
+			Ref:        m.Ref,
+			TemplateID: m.TemplateId,
+			CreatedAt:  now,
+			DueAt:      now.Add(d.window),
+		}
+
+		snap, err := tx.Get(doc)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			if err := snap.DataTo(b); err != nil {
+				return err
+			}
+		}
+
+		b.Count++
+		if len(b.Items) < maxItems {
+			item := m.TemplateData
+			if item == nil {
+				item = map[string]string{}
+			}
+			b.Items = append(b.Items, item)
+		}
+		if len(m.Data) > 0 {
+			b.Data = m.Data
+		}
+
+		return tx.Set(doc, b)
+	})
+}
+
+// countInDigest increments the count of the bucket if all of its items are listed.
+// It returns false if the bucket doesn't exist or has room for the item of the message
+func (s *Service) countInDigest(ctx context.Context, doc *firestore.DocumentRef, m *v1.Message, maxItems int) (bool, error) {
+	snap, err := doc.Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	items, _ := snap.DataAt("items")
+	if listed, _ := items.([]interface{}); len(listed) < maxItems {
+		return false, nil
+	}
+
+	updates := []firestore.Update{{Path: "count", Value: firestore.Increment(1)}}
+	if len(m.Data) > 0 {
+		updates = append(updates, firestore.Update{Path: "data", Value: m.Data})
+	}
+
+	// the bucket may be flushed in the meantime, the message starts a new one then
+	_, err = doc.Update(ctx, updates)
+	if status.Code(err) == codes.NotFound {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// flushDigests schedules the digests of the buckets whose window ended. Buckets are
+// replaced by their scheduled notifications in a transaction, so each is sent once
+func (s *Service) flushDigests(ctx context.Context) error {
+	docs, err := s.FirestoreClient.Collection(s.CollectionPrefix+digestsCollection).
+		Where("dueAt", "<=", time.Now()).
+		OrderBy("dueAt", firestore.Asc).
+		Limit(schedulerBatchSize).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return err
+	}
+
+	for _, doc := range docs {
+		if err := s.flushDigest(ctx, doc.Ref); err != nil {
+			s.Warn("Cannot flush digest", zap.String("id", doc.Ref.ID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// flushDigest replaces the due bucket by the scheduled notification of its digest
+func (s *Service) flushDigest(ctx context.Context, doc *firestore.DocumentRef) error {
+	return s.FirestoreClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		snap, err := tx.Get(doc)
+		if status.Code(err) == codes.NotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		b := &digestBucket{}
+		if err := snap.DataTo(b); err != nil {
+			return err
+		}
+		if b.DueAt.After(time.Now()) {
+			return nil
+		}
+
+		m, err := s.digestMessage(b)
+		if err != nil {
+			return err
+		}
+
+		// the id of the bucket and its window identify the digest
+		id := fmt.Sprintf("digest-%s-%d", doc.ID, b.CreatedAt.UnixNano())
+		m.IdempotencyKey = id
+
+		n, err := scheduledNotification(methodSendDigest, &v1.SendRequest{Message: m}, ptypes.TimestampNow())
+		if err != nil {
+			return err
+		}
+
+		scheduled := s.FirestoreClient.Collection(s.CollectionPrefix + scheduledCollection).Doc(id)
+		if err := tx.Create(scheduled, n); err != nil {
+			return err
+		}
+
+		s.Debug("Digest was scheduled",
+			zap.String("templateID", b.TemplateID),
+			zap.String("ref", b.Ref),
+			zap.Int("count", b.Count),
+		)
+
+		return tx.Delete(doc)
+	})
+}
+
+// digestMessage returns the message of the bucket. A single message is sent unchanged,
+// more messages are sent as the digest template
+func (s *Service) digestMessage(b *digestBucket) (*v1.Message, error) {
+	m := &v1.Message{
+		TemplateId: b.TemplateID,
+		Ref:        b.Ref,
+		Data:       b.Data,
+	}
+	if len(b.Items) > 0 {
+		m.TemplateData = b.Items[0]
+	}
+	if b.Count == 1 {
+		return m, nil
+	}
+
+	t, err := s.template(b.TemplateID)
+	if err != nil {
+		return nil, err
+	}
+	d := t.GetDigest()
+	if d == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "template %q is not digested", b.TemplateID)
+	}
+
+	separator := d.Separator
+	if separator == "" {
+		separator = defaultDigestSeparator
+	}
+
+	items := make([]string, 0, len(b.Items))
+	for _, data := range b.Items {
+		item, err := renderString(d.Item, data)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	// the digest is rendered with the template data of the first message and the summary
+	templateData := map[string]string{}
+	for k, v := range m.TemplateData {
+		templateData[k] = v
+	}
+	templateData["count"] = strconv.Itoa(b.Count)
+	templateData["items"] = strings.Join(items, separator)
+	templateData["more"] = strconv.Itoa(b.Count - len(b.Items))
+
+	m.TemplateId = d.TemplateId
+	m.TemplateData = templateData
+
+	return m, nil
+}
@@ -0,0 +1,97 @@
+package companion
+
+import (
+	"github.com/golang/protobuf/proto"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"reflect"
+	"testing"
+)
+
+func newDigestService() *Service {
+	return &Service{
+		Logger: zap.NewNop(),
+		config: &v1.NotificationConfig{
+			Messages: []*v1.MessageTemplate{
+				{
+					Id: "like",
+					Digest: &v1.Digest{
+						Window:     "15m",
+						TemplateId: "likes",
+						Item:       "{{.user}} liked your post",
+						MaxItems:   2,
+					},
+				},
+				{Id: "likes"},
+			},
+		},
+	}
+}
+
+func TestDigestMessageSingle(t *testing.T) {
+	s := newDigestService()
+
+	m, err := s.digestMessage(&digestBucket{
+		Ref:        "u1",
+		TemplateID: "like",
+		Count:      1,
+		Items:      []map[string]string{{"user": "alice"}},
+		Data:       map[string]string{"post": "p1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &v1.Message{
+		TemplateId:   "like",
+		Ref:          "u1",
+		TemplateData: map[string]string{"user": "alice"},
+		Data:         map[string]string{"post": "p1"},
+	}
+	if !proto.Equal(m, want) {
+		t.Errorf("digestMessage = %v, want %v", m, want)
+	}
+}
+
+func TestDigestMessage(t *testing.T) {
+	s := newDigestService()
+
+	m, err := s.digestMessage(&digestBucket{
+		Ref:        "u1",
+		TemplateID: "like",
+		Count:      5,
+		Items:      []map[string]string{{"user": "alice", "post": "p1"}, {"user": "bob"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if m.TemplateId != "likes" || m.Ref != "u1" {
+		t.Errorf("digestMessage = %v, want the likes template sent to u1", m)
+	}
+
+	want := map[string]string{
+		"user":  "alice",
+		"post":  "p1",
+		"count": "5",
+		"items": "alice liked your post\nbob liked your post",
+		"more":  "3",
+	}
+	if !reflect.DeepEqual(m.TemplateData, want) {
+		t.Errorf("template data = %v, want %v", m.TemplateData, want)
+	}
+}
+
+func TestDigestMessageErrors(t *testing.T) {
+	s := newDigestService()
+
+	for name, b := range map[string]*digestBucket{
+		"unknown template": {TemplateID: "unknown", Count: 2},
+		"not digested":     {TemplateID: "likes", Count: 2},
+		"missing item key": {TemplateID: "like", Count: 2, Items: []map[string]string{{}}},
+	} {
+		if _, err := s.digestMessage(b); err == nil {
+			t.Errorf("%s: digestMessage succeeded, want an error", name)
+		}
+	}
+}
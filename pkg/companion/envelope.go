@@ -0,0 +1,137 @@
+package companion
+
+import (
+	"context"
+	"firebase.google.com/go/v4/messaging"
+	"github.com/petomalina/xrpc/pkg/multiplexer"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// AttributeMethod is the Pub/Sub message attribute that routes the message to
+	// an RPC, e.g. SendAll. It takes precedence over the subscription method of
+	// pulled messages and over the path of the push endpoint of pushed messages
+	AttributeMethod = "method"
+	// AttributeIdempotencyKey is the Pub/Sub message attribute used to deduplicate
+	// messages instead of the message ID, e.g. when the publisher retries
+	AttributeIdempotencyKey = "idempotency-key"
+	// AttributePriority is the Pub/Sub message attribute that overrides the
+	// priority of the sent notifications, either "high" or "normal"
+	AttributePriority = "priority"
+	// AttributeTenant is the Pub/Sub message attribute that scopes deduplication
+	// and dead letters of the message to a tenant
+	AttributeTenant = "tenant"
+
+	priorityHigh   = "high"
+	priorityNormal = "normal"
+)
+
+// envelope holds the meta information and attributes of a message delivered
+// by Pub/Sub, regardless if it was pushed or pulled
+type envelope struct {
+	MessageID    string
+	Subscription string
+	Attributes   map[string]string
+}
+
+// envelopeFromContext returns the envelope of a request delivered by Pub/Sub.
+// These are passed as metadata by the multiplexer.PubSubHandler and the subscriber
+func envelopeFromContext(ctx context.Context) (*envelope, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	// the message ID is always present for Pub/Sub requests, even if empty
+	if len(md.Get(multiplexer.PubSubMetaAttributeHeader(multiplexer.PubSubMetaMessageID))) == 0 {
+		return nil, false
+	}
+
+	get := func(key string) string {
+		if vv := md.Get(key); len(vv) > 0 {
+			return vv[0]
+		}
+		return ""
+	}
+
+	env := &envelope{
+		MessageID:    get(multiplexer.PubSubMetaAttributeHeader(multiplexer.PubSubMetaMessageID)),
+		Subscription: get(multiplexer.PubSubMetaAttributeHeader(multiplexer.PubSubMetaSubscription)),
+		Attributes:   map[string]string{},
+	}
+
+	for _, attr := range []string{AttributeMethod, AttributeIdempotencyKey, AttributePriority, AttributeTenant} {
+		if v := get(multiplexer.PubSubAttributeHeader(attr)); v != "" {
+			env.Attributes[attr] = v
+		}
+	}
+
+	return env, true
+}
+
+// newIncomingContext returns a context carrying the envelope in the same shape
+// as requests pushed through the multiplexer.PubSubHandler
+func (e *envelope) newIncomingContext(ctx context.Context) context.Context {
+	md := metadata.Pairs(
+		multiplexer.PubSubMetaAttributeHeader(multiplexer.PubSubMetaMessageID), e.MessageID,
+		multiplexer.PubSubMetaAttributeHeader(multiplexer.PubSubMetaSubscription), e.Subscription,
+	)
+	for k, v := range e.Attributes {
+		md.Append(multiplexer.PubSubAttributeHeader(k), v)
+	}
+
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// Tenant returns the tenant attribute of the message
+func (e *envelope) Tenant() string {
+	return e.Attributes[AttributeTenant]
+}
+
+// DeliveryKey returns the key used to deduplicate redelivered messages. The idempotency
+// key attribute is preferred over the message ID. Keys are scoped by the tenant.
+// An empty key is returned if the message can't be deduplicated (e.g. the in-memory
+// driver doesn't provide message IDs)
+func (e *envelope) DeliveryKey() string {
+	var key string
+	switch {
+	case e.Attributes[AttributeIdempotencyKey] != "":
+		key = "key:" + e.Attributes[AttributeIdempotencyKey]
+	case e.MessageID != "":
+		key = "msg:" + e.MessageID
+	default:
+		return ""
+	}
+
+	if t := e.Tenant(); t != "" {
+		key = t + ":" + key
+	}
+
+	return key
+}
+
+// applyPriority overrides the platform priorities with the priority attribute
+func (e *envelope) applyPriority(android **messaging.AndroidConfig, apns **messaging.APNSConfig) {
+	var apnsPriority string
+	switch e.Attributes[AttributePriority] {
+	case priorityHigh:
+		apnsPriority = "10"
+	case priorityNormal:
+		apnsPriority = "5"
+	default:
+		return
+	}
+
+	if *android == nil {
+		*android = &messaging.AndroidConfig{}
+	}
+	(*android).Priority = e.Attributes[AttributePriority]
+
+	if *apns == nil {
+		*apns = &messaging.APNSConfig{}
+	}
+	if (*apns).Headers == nil {
+		(*apns).Headers = map[string]string{}
+	}
+	(*apns).Headers["apns-priority"] = apnsPriority
+}
@@ -0,0 +1,100 @@
+package companion
+
+import (
+	"context"
+	"firebase.google.com/go/v4/messaging"
+	"reflect"
+	"testing"
+)
+
+func TestEnvelopeDeliveryKey(t *testing.T) {
+	tests := []struct {
+		name string
+		env  envelope
+		want string
+	}{
+		{name: "message id", env: envelope{MessageID: "m1"}, want: "msg:m1"},
+		{
+			name: "idempotency key",
+			env:  envelope{MessageID: "m1", Attributes: map[string]string{AttributeIdempotencyKey: "k1"}},
+			want: "key:k1",
+		},
+		{
+			name: "tenant",
+			env:  envelope{MessageID: "m1", Attributes: map[string]string{AttributeTenant: "acme"}},
+			want: "acme:msg:m1",
+		},
+		{
+			name: "tenant and idempotency key",
+			env:  envelope{Attributes: map[string]string{AttributeTenant: "acme", AttributeIdempotencyKey: "k1"}},
+			want: "acme:key:k1",
+		},
+		{name: "no id", env: envelope{Attributes: map[string]string{AttributeTenant: "acme"}}, want: ""},
+	}
+
+	for _, tt := range tests {
+		if key := tt.env.DeliveryKey(); key != tt.want {
+			t.Errorf("%s: DeliveryKey() = %q, want %q", tt.name, key, tt.want)
+		}
+	}
+}
+
+func TestDeadLetterIDTenant(t *testing.T) {
+	acme := &envelope{MessageID: "m1", Attributes: map[string]string{AttributeTenant: "acme"}}
+	other := &envelope{MessageID: "m1", Attributes: map[string]string{AttributeTenant: "other"}}
+
+	if deadLetterID(acme) == deadLetterID(other) {
+		t.Error("dead letters of the same message of different tenants share the id")
+	}
+	if id := deadLetterID(acme); id != deadLetterID(acme) || len(id) != 64 {
+		t.Errorf("deadLetterID = %q, want a stable hex SHA-256", id)
+	}
+	if id := deadLetterID(&envelope{}); id != "" {
+		t.Errorf("deadLetterID without a key = %q, want empty", id)
+	}
+}
+
+func TestEnvelopeContext(t *testing.T) {
+	if _, ok := envelopeFromContext(context.Background()); ok {
+		t.Error("envelope found in a context of a request not delivered by Pub/Sub")
+	}
+
+	env := &envelope{
+		MessageID:    "m1",
+		Subscription: "projects/p/subscriptions/s",
+		Attributes: map[string]string{
+			AttributeMethod:         MethodSendAll,
+			AttributeIdempotencyKey: "k1",
+			AttributePriority:       priorityHigh,
+			AttributeTenant:         "acme",
+			"unknown":               "dropped",
+		},
+	}
+
+	got, ok := envelopeFromContext(env.newIncomingContext(context.Background()))
+	if !ok {
+		t.Fatal("envelope not found")
+	}
+
+	delete(env.Attributes, "unknown")
+	if !reflect.DeepEqual(got, env) {
+		t.Errorf("envelope = %+v, want %+v", got, env)
+	}
+}
+
+func TestEnvelopeApplyPriority(t *testing.T) {
+	env := &envelope{Attributes: map[string]string{AttributePriority: priorityNormal}}
+	msg := &messaging.Message{}
+
+	env.applyPriority(&msg.Android, &msg.APNS)
+
+	if msg.Android.Priority != priorityNormal || msg.APNS.Headers["apns-priority"] != "5" {
+		t.Errorf("android = %+v, apns = %+v, want the normal priority", msg.Android, msg.APNS)
+	}
+
+	msg = &messaging.Message{}
+	(&envelope{Attributes: map[string]string{AttributePriority: "urgent"}}).applyPriority(&msg.Android, &msg.APNS)
+	if msg.Android != nil || msg.APNS != nil {
+		t.Errorf("message = %+v, want unknown priorities ignored", msg)
+	}
+}
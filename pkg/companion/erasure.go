@@ -0,0 +1,218 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// requestRecipients returns the refs and the tokens the send request is sent to
+func requestRecipients(r proto.Message) (refs, tokens []string) {
+	add := func(ref, token string) {
+		if ref != "" {
+			refs = append(refs, ref)
+		}
+		if token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+
+	switch r := r.(type) {
+	case *v1.SendRequest:
+		add(r.GetMessage().GetRef(), r.GetMessage().GetToken())
+	case *v1.SendAllRequest:
+		for _, m := range r.Messages {
+			add(m.Ref, m.Token)
+		}
+	case *v1.SendMulticastRequest:
+		tokens = append(tokens, r.GetMessage().GetTokens()...)
+	}
+
+	return refs, tokens
+}
+
+// sendRequest returns the empty request of the method, or nil if the method is unknown
+func sendRequest(method string) proto.Message {
+	switch method {
+	case MethodSend, methodSendDigest:
+		return &v1.SendRequest{}
+	case MethodSendAll:
+		return &v1.SendAllRequest{}
+	case MethodSendMulticast:
+		return &v1.SendMulticastRequest{}
+	}
+
+	return nil
+}
+
+// withoutUser removes the messages sent to the ref or the tokens from the request.
+// It returns false if nothing is left to be sent
+func withoutUser(r proto.Message, ref string, tokens map[string]bool) bool {
+	removed := func(m *v1.Message) bool {
+		return m.GetRef() == ref || tokens[m.GetToken()]
+	}
+
+	switch r := r.(type) {
+	case *v1.SendRequest:
+		return !removed(r.Message)
+	case *v1.SendAllRequest:
+		var kept []*v1.Message
+		for _, m := range r.Messages {
+			if !removed(m) {
+				kept = append(kept, m)
+			}
+		}
+		r.Messages = kept
+		return len(kept) > 0
+	case *v1.SendMulticastRequest:
+		m := r.GetMessage()
+		if len(m.GetTokens()) == 0 {
+			return true
+		}
+
+		var kept []string
+		for _, t := range m.Tokens {
+			if !tokens[t] {
+				kept = append(kept, t)
+			}
+		}
+		m.Tokens = kept
+		return len(kept) > 0
+	}
+
+	return true
+}
+
+// removeUserData removes the data of the ref and its instances stored besides the
+// instances. Notifications and digests of the ref are removed, scheduled notifications
+// and dead letters are removed only if sent to nobody else
+func (s *Service) removeUserData(ctx context.Context, ref string, instances []*v1.AppInstance) error {
+	tokens := map[string]bool{}
+	var tokenList, instanceIDs []string
+	for _, i := range instances {
+		instanceIDs = append(instanceIDs, i.InstanceId)
+		if i.Token != "" && !tokens[i.Token] {
+			tokens[i.Token] = true
+			tokenList = append(tokenList, i.Token)
+		}
+	}
+
+	notifications := s.FirestoreClient.Collection(s.CollectionPrefix + notificationsCollection)
+	queries := []firestore.Query{
+		notifications.Where("ref", "==", ref),
+		s.FirestoreClient.Collection(s.CollectionPrefix+digestsCollection).Where("ref", "==", ref),
+	}
+	for _, chunk := range chunks(instanceIDs, maxInValues) {
+		queries = append(queries, notifications.Where("instanceIds", "array-contains-any", chunk))
+	}
+	for _, q := range queries {
+		if err := s.deleteQuery(ctx, q); err != nil {
+			return err
+		}
+	}
+
+	for _, collection := range []string{scheduledCollection, deadLettersCollection} {
+		col := s.FirestoreClient.Collection(s.CollectionPrefix + collection)
+
+		queries := []firestore.Query{col.Where("refs", "array-contains", ref)}
+		for _, chunk := range chunks(tokenList, maxInValues) {
+			queries = append(queries, col.Where("tokens", "array-contains-any", chunk))
+		}
+
+		for _, q := range queries {
+			docs, err := q.Documents(ctx).GetAll()
+			if err != nil {
+				return err
+			}
+
+			for _, doc := range docs {
+				if err := s.removeUserPayload(ctx, doc.Ref, ref, tokens); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// removeUserPayload removes the ref and the tokens from the payload of the scheduled
+// notification or the dead letter, or the whole document if nothing else is left
+func (s *Service) removeUserPayload(ctx context.Context, doc *firestore.DocumentRef, ref string, tokens map[string]bool) error {
+	return s.FirestoreClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		snap, err := tx.Get(doc)
+		if status.Code(err) == codes.NotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		method, _ := snap.DataAt("method")
+		payload, _ := snap.DataAt("payload")
+		m, _ := method.(string)
+		body, _ := payload.(string)
+
+		// undecodable payloads can't be told apart, so they're removed with the user
+		r := sendRequest(m)
+		if r == nil || unmarshalBody([]byte(body), r) != nil || !withoutUser(r, ref, tokens) {
+			s.Debug("Payload of the removed user was removed", zap.String("id", doc.ID))
+			return tx.Delete(doc)
+		}
+
+		cleaned, err := (&jsonpb.Marshaler{}).MarshalToString(r)
+		if err != nil {
+			return err
+		}
+		refs, recipients := requestRecipients(r)
+
+		return tx.Update(doc, []firestore.Update{
+			{Path: "payload", Value: cleaned},
+			{Path: "refs", Value: refs},
+			{Path: "tokens", Value: recipients},
+		})
+	})
+}
+
+// deleteQuery deletes all documents matched by the query in batches
+func (s *Service) deleteQuery(ctx context.Context, q firestore.Query) error {
+	for {
+		docs, err := q.Limit(maxBatchSize).Documents(ctx).GetAll()
+		if err != nil {
+			return err
+		}
+		if len(docs) == 0 {
+			return nil
+		}
+
+		batch := s.FirestoreClient.Batch()
+		for _, doc := range docs {
+			batch.Delete(doc.Ref)
+		}
+		if _, err := batch.Commit(ctx); err != nil {
+			return err
+		}
+
+		if len(docs) < maxBatchSize {
+			return nil
+		}
+	}
+}
+
+// chunks splits the values into chunks of at most the size
+func chunks(values []string, size int) [][]string {
+	var split [][]string
+	for start := 0; start < len(values); start += size {
+		end := start + size
+		if end > len(values) {
+			end = len(values)
+		}
+		split = append(split, values[start:end])
+	}
+
+	return split
+}
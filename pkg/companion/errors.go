@@ -0,0 +1,106 @@
+package companion
+
+import (
+	"firebase.google.com/go/v4/messaging"
+	"fmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fcmError converts errors returned by the FCM into status errors, so they are
+// classified the same way for grpc, gateway and Pub/Sub deliveries
+func fcmError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case messaging.IsInvalidArgument(err), messaging.IsTooManyTopics(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case messaging.IsUnregistered(err), messaging.IsRegistrationTokenNotRegistered(err):
+		return status.Error(codes.NotFound, err.Error())
+	case messaging.IsSenderIDMismatch(err), messaging.IsMismatchedCredential(err),
+		messaging.IsThirdPartyAuthError(err), messaging.IsInvalidAPNSCredentials(err):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case messaging.IsQuotaExceeded(err), messaging.IsMessageRateExceeded(err):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case messaging.IsUnavailable(err), messaging.IsServerUnavailable(err):
+		return status.Error(codes.Unavailable, err.Error())
+	case messaging.IsInternal(err):
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	return status.Error(codes.Unknown, err.Error())
+}
+
+// statusError converts the validation errors generated by the protoc-gen-validate into
+// InvalidArgument errors, so their code is kept when stored as the result of a request
+func statusError(err error) error {
+	if _, ok := err.(interface{ Reason() string }); ok {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return err
+}
+
+// partiallySent converts the error of a send that already sent some of the messages
+// into a permanent error, so the sent messages are not duplicated by the retries
+func partiallySent(err error) error {
+	if err == nil || !isRetryable(err) {
+		return err
+	}
+
+	return status.Errorf(codes.FailedPrecondition, "message was partially sent and is not retried: %v", err)
+}
+
+// panicError is returned for requests whose processing panicked
+type panicError struct {
+	method string
+	value  interface{}
+}
+
+func (e *panicError) Error() string {
+	return fmt.Sprintf("method %q panicked: %v", e.method, e.value)
+}
+
+// GRPCStatus returns the Internal status of the panic
+func (e *panicError) GRPCStatus() *status.Status {
+	return status.New(codes.Internal, e.Error())
+}
+
+// isRetryable returns true if the error is considered transient and the
+// operation may succeed if called again
+func isRetryable(err error) bool {
+	// panics would most likely repeat, even though they are Internal errors
+	if _, ok := err.(*panicError); ok {
+		return false
+	}
+
+	// validation errors are generated by the protoc-gen-validate
+	if _, ok := err.(interface{ Reason() string }); ok {
+		return false
+	}
+
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.Unavailable, codes.Internal, codes.ResourceExhausted,
+			codes.DeadlineExceeded, codes.Aborted, codes.Unknown, codes.Canceled:
+			return true
+		default:
+			return false
+		}
+	}
+
+	// unknown failures are retried rather than lost
+	return true
+}
+
+// isInvalidToken returns true if the FCM rejected the token of the message
+// and it will never be accepted again
+func isInvalidToken(err error) bool {
+	return messaging.IsUnregistered(err) ||
+		messaging.IsRegistrationTokenNotRegistered(err) ||
+		messaging.IsInvalidArgument(err)
+}
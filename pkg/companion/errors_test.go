@@ -0,0 +1,59 @@
+package companion
+
+import (
+	"context"
+	"errors"
+	"firebase.google.com/go/v4/messaging"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestIsRetryable(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "unavailable", err: status.Error(codes.Unavailable, "unavailable"), want: true},
+		{name: "aborted", err: status.Error(codes.Aborted, "aborted"), want: true},
+		{name: "resource exhausted", err: status.Error(codes.ResourceExhausted, "quota"), want: true},
+		{name: "unknown error", err: errors.New("connection reset"), want: true},
+		{name: "invalid argument", err: status.Error(codes.InvalidArgument, "invalid"), want: false},
+		{name: "not found", err: status.Error(codes.NotFound, "unregistered"), want: false},
+		{name: "validation", err: (&v1.SendRequest{}).Validate(), want: false},
+		{name: "panic", err: &panicError{method: MethodSend, value: "nil map"}, want: false},
+	}
+
+	for _, tt := range tests {
+		if got := isRetryable(tt.err); got != tt.want {
+			t.Errorf("%s: isRetryable(%v) = %v, want %v", tt.name, tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestIsInvalidToken(t *testing.T) {
+	s, _ := newTestService(t)
+	fcm := newFakeFCM(t, s)
+
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{code: "UNREGISTERED", want: true},
+		{code: "INVALID_ARGUMENT", want: true},
+		{code: "UNAVAILABLE", want: false},
+	}
+
+	for _, tt := range tests {
+		fcm.errors[tt.code] = tt.code
+		_, err := s.MessagingClient.Send(context.Background(), &messaging.Message{Token: tt.code})
+		if err == nil {
+			t.Fatalf("%s: message was sent", tt.code)
+		}
+		if got := isInvalidToken(err); got != tt.want {
+			t.Errorf("%s: isInvalidToken(%v) = %v, want %v", tt.code, err, got, tt.want)
+		}
+	}
+}
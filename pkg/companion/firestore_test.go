@@ -0,0 +1,825 @@
+package companion
+
+import (
+	"bytes"
+	"cloud.google.com/go/firestore"
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/api/option"
+	pb "google.golang.org/genproto/googleapis/firestore/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"math"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeFirestore is an in-memory Firestore server implementing the calls used by the
+// companion: document reads and writes with preconditions and transforms, transactions
+// without contention and structured queries of a single collection. Composite indexes
+// are not required
+type fakeFirestore struct {
+	pb.UnimplementedFirestoreServer
+
+	mu   sync.Mutex
+	docs map[string]*pb.Document
+	// last is the time of the last commit, commits get strictly increasing times
+	last time.Time
+	// failCommit fails the commits it returns an error for
+	failCommit func(r *pb.CommitRequest) error
+}
+
+// newTestService returns a Service backed by an in-memory Firestore, configured
+// with the empty hello template
+func newTestService(t *testing.T) (*Service, *fakeFirestore) {
+	t.Helper()
+
+	f := &fakeFirestore{docs: map[string]*pb.Document{}}
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	pb.RegisterFirestoreServer(srv, f)
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+
+	ctx := context.Background()
+	conn, err := grpc.DialContext(ctx, "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithInsecure(),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, err := firestore.NewClient(ctx, "test", option.WithGRPCConn(conn))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() {
+		_ = client.Close()
+		srv.Stop()
+	})
+
+	return &Service{
+		Logger:          zap.NewNop(),
+		FirestoreClient: client,
+		config: &v1.NotificationConfig{
+			Messages: []*v1.MessageTemplate{{Id: "hello", FcmMessage: &v1.FCMMessage{}}},
+		},
+	}, f
+}
+
+// count returns the number of documents stored in the collection
+func (f *fakeFirestore) count(collection string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	n := 0
+	for name := range f.docs {
+		if path := name[strings.Index(name, "/documents/")+len("/documents/"):]; strings.HasPrefix(path, collection+"/") &&
+			!strings.Contains(strings.TrimPrefix(path, collection+"/"), "/") {
+			n++
+		}
+	}
+	return n
+}
+
+func (f *fakeFirestore) now() *timestamp.Timestamp {
+	now := time.Now()
+	if !now.After(f.last) {
+		now = f.last.Add(time.Microsecond)
+	}
+	f.last = now
+
+	ts, _ := ptypes.TimestampProto(now)
+	return ts
+}
+
+func (f *fakeFirestore) BatchGetDocuments(r *pb.BatchGetDocumentsRequest, stream pb.Firestore_BatchGetDocumentsServer) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	readTime := f.now()
+	for _, name := range r.Documents {
+		res := &pb.BatchGetDocumentsResponse{ReadTime: readTime}
+		if doc, ok := f.docs[name]; ok {
+			res.Result = &pb.BatchGetDocumentsResponse_Found{Found: proto.Clone(doc).(*pb.Document)}
+		} else {
+			res.Result = &pb.BatchGetDocumentsResponse_Missing{Missing: name}
+		}
+
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (f *fakeFirestore) BeginTransaction(context.Context, *pb.BeginTransactionRequest) (*pb.BeginTransactionResponse, error) {
+	return &pb.BeginTransactionResponse{Transaction: []byte("tx")}, nil
+}
+
+func (f *fakeFirestore) Rollback(context.Context, *pb.RollbackRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, nil
+}
+
+func (f *fakeFirestore) Commit(_ context.Context, r *pb.CommitRequest) (*pb.CommitResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.failCommit != nil {
+		if err := f.failCommit(r); err != nil {
+			return nil, err
+		}
+	}
+
+	// writes are applied to a copy, so the commit is atomic
+	docs := make(map[string]*pb.Document, len(f.docs))
+	for name, doc := range f.docs {
+		docs[name] = doc
+	}
+
+	now := f.now()
+	res := &pb.CommitResponse{CommitTime: now}
+	for _, w := range r.Writes {
+		if err := applyWrite(docs, w, now); err != nil {
+			return nil, err
+		}
+		res.WriteResults = append(res.WriteResults, &pb.WriteResult{UpdateTime: now})
+	}
+
+	f.docs = docs
+	return res, nil
+}
+
+// applyWrite applies the write to the documents
+func applyWrite(docs map[string]*pb.Document, w *pb.Write, now *timestamp.Timestamp) error {
+	var name string
+	switch op := w.Operation.(type) {
+	case *pb.Write_Update:
+		name = op.Update.Name
+	case *pb.Write_Delete:
+		name = op.Delete
+	case *pb.Write_Transform:
+		name = op.Transform.Document
+	}
+
+	cur, exists := docs[name]
+	switch c := w.GetCurrentDocument().GetConditionType().(type) {
+	case *pb.Precondition_Exists:
+		if c.Exists && !exists {
+			return status.Errorf(codes.NotFound, "no entity to update: %s", name)
+		}
+		if !c.Exists && exists {
+			return status.Errorf(codes.AlreadyExists, "entity already exists: %s", name)
+		}
+	case *pb.Precondition_UpdateTime:
+		if !exists || !proto.Equal(cur.UpdateTime, c.UpdateTime) {
+			return status.Errorf(codes.FailedPrecondition, "the stored update time doesn't match: %s", name)
+		}
+	}
+
+	doc := &pb.Document{Name: name, Fields: map[string]*pb.Value{}, CreateTime: now}
+	if exists {
+		doc = proto.Clone(cur).(*pb.Document)
+		if doc.Fields == nil {
+			doc.Fields = map[string]*pb.Value{}
+		}
+	}
+	doc.UpdateTime = now
+
+	var transforms []*pb.DocumentTransform_FieldTransform
+	switch op := w.Operation.(type) {
+	case *pb.Write_Delete:
+		delete(docs, name)
+		return nil
+	case *pb.Write_Update:
+		if w.UpdateMask == nil {
+			doc.Fields = proto.Clone(op.Update).(*pb.Document).Fields
+			if doc.Fields == nil {
+				doc.Fields = map[string]*pb.Value{}
+			}
+			break
+		}
+
+		for _, path := range w.UpdateMask.FieldPaths {
+			fp := splitFieldPath(path)
+			if v, ok := getField(op.Update.Fields, fp); ok {
+				setField(doc.Fields, fp, proto.Clone(v).(*pb.Value))
+			} else {
+				deleteField(doc.Fields, fp)
+			}
+		}
+	case *pb.Write_Transform:
+		transforms = op.Transform.FieldTransforms
+	}
+
+	for _, t := range append(transforms, w.UpdateTransforms...) {
+		fp := splitFieldPath(t.FieldPath)
+		cur, _ := getField(doc.Fields, fp)
+		setField(doc.Fields, fp, applyTransform(t, cur, now))
+	}
+
+	docs[name] = doc
+	return nil
+}
+
+// applyTransform returns the value of the field after the transform
+func applyTransform(t *pb.DocumentTransform_FieldTransform, cur *pb.Value, now *timestamp.Timestamp) *pb.Value {
+	elements := func() []*pb.Value {
+		if a, ok := cur.GetValueType().(*pb.Value_ArrayValue); ok {
+			return a.ArrayValue.Values
+		}
+		return nil
+	}
+	contains := func(vs []*pb.Value, v *pb.Value) bool {
+		for _, e := range vs {
+			if compareValues(e, v) == 0 {
+				return true
+			}
+		}
+		return false
+	}
+
+	switch tt := t.TransformType.(type) {
+	case *pb.DocumentTransform_FieldTransform_SetToServerValue:
+		return &pb.Value{ValueType: &pb.Value_TimestampValue{TimestampValue: now}}
+	case *pb.DocumentTransform_FieldTransform_Increment:
+		ci, curInt := cur.GetValueType().(*pb.Value_IntegerValue)
+		ii, incInt := tt.Increment.GetValueType().(*pb.Value_IntegerValue)
+		if (curInt || typeOrder(cur) != 2) && incInt {
+			var base int64
+			if curInt {
+				base = ci.IntegerValue
+			}
+			return &pb.Value{ValueType: &pb.Value_IntegerValue{IntegerValue: base + ii.IntegerValue}}
+		}
+
+		var base float64
+		if typeOrder(cur) == 2 {
+			base = number(cur)
+		}
+		return &pb.Value{ValueType: &pb.Value_DoubleValue{DoubleValue: base + number(tt.Increment)}}
+	case *pb.DocumentTransform_FieldTransform_Maximum:
+		if typeOrder(cur) == 2 && number(cur) >= number(tt.Maximum) {
+			return cur
+		}
+		return tt.Maximum
+	case *pb.DocumentTransform_FieldTransform_Minimum:
+		if typeOrder(cur) == 2 && number(cur) <= number(tt.Minimum) {
+			return cur
+		}
+		return tt.Minimum
+	case *pb.DocumentTransform_FieldTransform_AppendMissingElements:
+		values := append([]*pb.Value{}, elements()...)
+		for _, v := range tt.AppendMissingElements.Values {
+			if !contains(values, v) {
+				values = append(values, v)
+			}
+		}
+		return &pb.Value{ValueType: &pb.Value_ArrayValue{ArrayValue: &pb.ArrayValue{Values: values}}}
+	case *pb.DocumentTransform_FieldTransform_RemoveAllFromArray:
+		var values []*pb.Value
+		for _, v := range elements() {
+			if !contains(tt.RemoveAllFromArray.Values, v) {
+				values = append(values, v)
+			}
+		}
+		return &pb.Value{ValueType: &pb.Value_ArrayValue{ArrayValue: &pb.ArrayValue{Values: values}}}
+	}
+
+	return cur
+}
+
+func (f *fakeFirestore) RunQuery(r *pb.RunQueryRequest, stream pb.Firestore_RunQueryServer) error {
+	q := r.GetStructuredQuery()
+	if q == nil || len(q.From) != 1 {
+		return status.Error(codes.Unimplemented, "only structured queries of a single collection are supported")
+	}
+
+	f.mu.Lock()
+	var docs []*pb.Document
+	for name, doc := range f.docs {
+		if inCollection(r.Parent, q.From[0], name) && matches(q.Where, doc) {
+			docs = append(docs, proto.Clone(doc).(*pb.Document))
+		}
+	}
+	readTime := f.now()
+	f.mu.Unlock()
+
+	orders := queryOrders(q)
+
+	// documents without the ordered fields are not returned
+	values := map[*pb.Document][]*pb.Value{}
+	var ordered []*pb.Document
+	for _, doc := range docs {
+		var vs []*pb.Value
+		for _, o := range orders {
+			v, ok := fieldValue(doc, o.Field.FieldPath)
+			if !ok {
+				break
+			}
+			vs = append(vs, v)
+		}
+		if len(vs) == len(orders) {
+			values[doc] = vs
+			ordered = append(ordered, doc)
+		}
+	}
+
+	sort.Slice(ordered, func(i, j int) bool {
+		return compareOrdered(orders, values[ordered[i]], values[ordered[j]]) < 0
+	})
+
+	var results []*pb.Document
+	for _, doc := range ordered {
+		if c := q.StartAt; c != nil {
+			cmp := compareOrdered(orders, values[doc], c.Values)
+			if cmp < 0 || cmp == 0 && !c.Before {
+				continue
+			}
+		}
+		if c := q.EndAt; c != nil {
+			cmp := compareOrdered(orders, values[doc], c.Values)
+			if cmp > 0 || cmp == 0 && c.Before {
+				continue
+			}
+		}
+		results = append(results, doc)
+	}
+
+	if offset := int(q.Offset); offset < len(results) {
+		results = results[offset:]
+	} else {
+		results = nil
+	}
+	if q.Limit != nil && int(q.Limit.Value) < len(results) {
+		results = results[:q.Limit.Value]
+	}
+
+	for _, doc := range results {
+		if err := stream.Send(&pb.RunQueryResponse{Document: doc, ReadTime: readTime}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inCollection returns true if the document is in the collection selected under the parent
+func inCollection(parent string, from *pb.StructuredQuery_CollectionSelector, name string) bool {
+	if !strings.HasPrefix(name, parent+"/") {
+		return false
+	}
+
+	path := strings.Split(strings.TrimPrefix(name, parent+"/"), "/")
+	if from.AllDescendants {
+		return len(path) >= 2 && path[len(path)-2] == from.CollectionId
+	}
+	return len(path) == 2 && path[0] == from.CollectionId
+}
+
+// queryOrders returns the orders of the query including the implicit ones: the field
+// of the inequality filter if no order is set, and the document name
+func queryOrders(q *pb.StructuredQuery) []*pb.StructuredQuery_Order {
+	orders := append([]*pb.StructuredQuery_Order{}, q.OrderBy...)
+
+	if len(orders) == 0 {
+		if field := inequalityField(q.Where); field != "" {
+			orders = append(orders, &pb.StructuredQuery_Order{
+				Field:     &pb.StructuredQuery_FieldReference{FieldPath: field},
+				Direction: pb.StructuredQuery_ASCENDING,
+			})
+		}
+	}
+
+	for _, o := range orders {
+		if o.Field.FieldPath == firestore.DocumentID {
+			return orders
+		}
+	}
+
+	dir := pb.StructuredQuery_ASCENDING
+	if len(orders) > 0 {
+		dir = orders[len(orders)-1].Direction
+	}
+	return append(orders, &pb.StructuredQuery_Order{
+		Field:     &pb.StructuredQuery_FieldReference{FieldPath: firestore.DocumentID},
+		Direction: dir,
+	})
+}
+
+// inequalityField returns the field of the first inequality filter
+func inequalityField(f *pb.StructuredQuery_Filter) string {
+	switch ft := f.GetFilterType().(type) {
+	case *pb.StructuredQuery_Filter_CompositeFilter:
+		for _, sub := range ft.CompositeFilter.Filters {
+			if field := inequalityField(sub); field != "" {
+				return field
+			}
+		}
+	case *pb.StructuredQuery_Filter_FieldFilter:
+		switch ft.FieldFilter.Op {
+		case pb.StructuredQuery_FieldFilter_LESS_THAN, pb.StructuredQuery_FieldFilter_LESS_THAN_OR_EQUAL,
+			pb.StructuredQuery_FieldFilter_GREATER_THAN, pb.StructuredQuery_FieldFilter_GREATER_THAN_OR_EQUAL,
+			pb.StructuredQuery_FieldFilter_NOT_EQUAL, pb.StructuredQuery_FieldFilter_NOT_IN:
+			return ft.FieldFilter.Field.FieldPath
+		}
+	}
+
+	return ""
+}
+
+// compareOrdered compares the ordered values of documents or cursors by the orders
+func compareOrdered(orders []*pb.StructuredQuery_Order, a, b []*pb.Value) int {
+	for i, o := range orders {
+		if i >= len(a) || i >= len(b) {
+			return 0
+		}
+
+		cmp := compareValues(a[i], b[i])
+		if o.Direction == pb.StructuredQuery_DESCENDING {
+			cmp = -cmp
+		}
+		if cmp != 0 {
+			return cmp
+		}
+	}
+
+	return 0
+}
+
+// matches returns true if the document passes the filter
+func matches(f *pb.StructuredQuery_Filter, doc *pb.Document) bool {
+	switch ft := f.GetFilterType().(type) {
+	case *pb.StructuredQuery_Filter_CompositeFilter:
+		for _, sub := range ft.CompositeFilter.Filters {
+			if !matches(sub, doc) {
+				return false
+			}
+		}
+		return true
+	case *pb.StructuredQuery_Filter_UnaryFilter:
+		v, ok := fieldValue(doc, ft.UnaryFilter.GetField().GetFieldPath())
+		isNull := ok && typeOrder(v) == 0
+		isNaN := ok && typeOrder(v) == 2 && math.IsNaN(number(v))
+		switch ft.UnaryFilter.Op {
+		case pb.StructuredQuery_UnaryFilter_IS_NULL:
+			return isNull
+		case pb.StructuredQuery_UnaryFilter_IS_NOT_NULL:
+			return ok && !isNull
+		case pb.StructuredQuery_UnaryFilter_IS_NAN:
+			return isNaN
+		case pb.StructuredQuery_UnaryFilter_IS_NOT_NAN:
+			return ok && !isNaN
+		}
+		return false
+	case *pb.StructuredQuery_Filter_FieldFilter:
+		return matchesField(ft.FieldFilter, doc)
+	}
+
+	return true
+}
+
+// matchesField returns true if the document passes the field filter. Like in Firestore,
+// the ranges only match values of the same type
+func matchesField(f *pb.StructuredQuery_FieldFilter, doc *pb.Document) bool {
+	v, ok := fieldValue(doc, f.Field.FieldPath)
+	if !ok {
+		return false
+	}
+
+	equal := func(a, b *pb.Value) bool {
+		return typeOrder(a) == typeOrder(b) && compareValues(a, b) == 0
+	}
+	elements := func(v *pb.Value) []*pb.Value {
+		return v.GetArrayValue().GetValues()
+	}
+	anyEqual := func(vs []*pb.Value, v *pb.Value) bool {
+		for _, e := range vs {
+			if equal(e, v) {
+				return true
+			}
+		}
+		return false
+	}
+
+	sameType := typeOrder(v) == typeOrder(f.Value)
+	cmp := compareValues(v, f.Value)
+
+	switch f.Op {
+	case pb.StructuredQuery_FieldFilter_LESS_THAN:
+		return sameType && cmp < 0
+	case pb.StructuredQuery_FieldFilter_LESS_THAN_OR_EQUAL:
+		return sameType && cmp <= 0
+	case pb.StructuredQuery_FieldFilter_GREATER_THAN:
+		return sameType && cmp > 0
+	case pb.StructuredQuery_FieldFilter_GREATER_THAN_OR_EQUAL:
+		return sameType && cmp >= 0
+	case pb.StructuredQuery_FieldFilter_EQUAL:
+		return equal(v, f.Value)
+	case pb.StructuredQuery_FieldFilter_NOT_EQUAL:
+		return typeOrder(v) != 0 && !equal(v, f.Value)
+	case pb.StructuredQuery_FieldFilter_ARRAY_CONTAINS:
+		return anyEqual(elements(v), f.Value)
+	case pb.StructuredQuery_FieldFilter_IN:
+		return anyEqual(elements(f.Value), v)
+	case pb.StructuredQuery_FieldFilter_NOT_IN:
+		return typeOrder(v) != 0 && !anyEqual(elements(f.Value), v)
+	case pb.StructuredQuery_FieldFilter_ARRAY_CONTAINS_ANY:
+		for _, e := range elements(v) {
+			if anyEqual(elements(f.Value), e) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// fieldValue returns the value of the field of the document, the document name
+// is returned for the DocumentID
+func fieldValue(doc *pb.Document, path string) (*pb.Value, bool) {
+	if path == firestore.DocumentID {
+		return &pb.Value{ValueType: &pb.Value_ReferenceValue{ReferenceValue: doc.Name}}, true
+	}
+
+	return getField(doc.Fields, splitFieldPath(path))
+}
+
+// splitFieldPath splits the field path into its fields, unquoting the quoted ones
+func splitFieldPath(path string) []string {
+	var fields []string
+	var field strings.Builder
+	quoted := false
+
+	for i := 0; i < len(path); i++ {
+		switch c := path[i]; {
+		case c == '\\' && quoted && i+1 < len(path):
+			i++
+			field.WriteByte(path[i])
+		case c == '`':
+			quoted = !quoted
+		case c == '.' && !quoted:
+			fields = append(fields, field.String())
+			field.Reset()
+		default:
+			field.WriteByte(c)
+		}
+	}
+
+	return append(fields, field.String())
+}
+
+func getField(fields map[string]*pb.Value, path []string) (*pb.Value, bool) {
+	v, ok := fields[path[0]]
+	if !ok || len(path) == 1 {
+		return v, ok
+	}
+
+	m := v.GetMapValue()
+	if m == nil {
+		return nil, false
+	}
+	return getField(m.Fields, path[1:])
+}
+
+func setField(fields map[string]*pb.Value, path []string, v *pb.Value) {
+	if len(path) == 1 {
+		fields[path[0]] = v
+		return
+	}
+
+	m := fields[path[0]].GetMapValue()
+	if m == nil {
+		m = &pb.MapValue{}
+		fields[path[0]] = &pb.Value{ValueType: &pb.Value_MapValue{MapValue: m}}
+	}
+	if m.Fields == nil {
+		m.Fields = map[string]*pb.Value{}
+	}
+	setField(m.Fields, path[1:], v)
+}
+
+func deleteField(fields map[string]*pb.Value, path []string) {
+	if len(path) == 1 {
+		delete(fields, path[0])
+		return
+	}
+
+	if m := fields[path[0]].GetMapValue(); m != nil {
+		deleteField(m.Fields, path[1:])
+	}
+}
+
+// typeOrder returns the order of the value type in the Firestore ordering
+func typeOrder(v *pb.Value) int {
+	switch v.GetValueType().(type) {
+	case *pb.Value_NullValue:
+		return 0
+	case *pb.Value_BooleanValue:
+		return 1
+	case *pb.Value_IntegerValue, *pb.Value_DoubleValue:
+		return 2
+	case *pb.Value_TimestampValue:
+		return 3
+	case *pb.Value_StringValue:
+		return 4
+	case *pb.Value_BytesValue:
+		return 5
+	case *pb.Value_ReferenceValue:
+		return 6
+	case *pb.Value_GeoPointValue:
+		return 7
+	case *pb.Value_ArrayValue:
+		return 8
+	case *pb.Value_MapValue:
+		return 9
+	}
+
+	return -1
+}
+
+func number(v *pb.Value) float64 {
+	if i, ok := v.GetValueType().(*pb.Value_IntegerValue); ok {
+		return float64(i.IntegerValue)
+	}
+	return v.GetDoubleValue()
+}
+
+// compareValues compares the values in the Firestore ordering
+func compareValues(a, b *pb.Value) int {
+	if ta, tb := typeOrder(a), typeOrder(b); ta != tb {
+		return compareInts(int64(ta), int64(tb))
+	}
+
+	switch av := a.GetValueType().(type) {
+	case *pb.Value_BooleanValue:
+		return compareInts(boolInt(av.BooleanValue), boolInt(b.GetBooleanValue()))
+	case *pb.Value_IntegerValue, *pb.Value_DoubleValue:
+		if bi, ok := b.GetValueType().(*pb.Value_IntegerValue); ok {
+			if ai, ok := av.(*pb.Value_IntegerValue); ok {
+				return compareInts(ai.IntegerValue, bi.IntegerValue)
+			}
+		}
+		x, y := number(a), number(b)
+		switch {
+		case x < y || math.IsNaN(x) && !math.IsNaN(y):
+			return -1
+		case x > y || !math.IsNaN(x) && math.IsNaN(y):
+			return 1
+		}
+	case *pb.Value_TimestampValue:
+		at, bt := av.TimestampValue, b.GetTimestampValue()
+		if c := compareInts(at.Seconds, bt.Seconds); c != 0 {
+			return c
+		}
+		return compareInts(int64(at.Nanos), int64(bt.Nanos))
+	case *pb.Value_StringValue:
+		return strings.Compare(av.StringValue, b.GetStringValue())
+	case *pb.Value_BytesValue:
+		return bytes.Compare(av.BytesValue, b.GetBytesValue())
+	case *pb.Value_ReferenceValue:
+		as, bs := strings.Split(av.ReferenceValue, "/"), strings.Split(b.GetReferenceValue(), "/")
+		for i := 0; i < len(as) && i < len(bs); i++ {
+			if c := strings.Compare(as[i], bs[i]); c != 0 {
+				return c
+			}
+		}
+		return compareInts(int64(len(as)), int64(len(bs)))
+	case *pb.Value_GeoPointValue:
+		ag, bg := av.GeoPointValue, b.GetGeoPointValue()
+		if ag.Latitude != bg.Latitude {
+			return compareValues(doubleValue(ag.Latitude), doubleValue(bg.Latitude))
+		}
+		return compareValues(doubleValue(ag.Longitude), doubleValue(bg.Longitude))
+	case *pb.Value_ArrayValue:
+		as, bs := av.ArrayValue.GetValues(), b.GetArrayValue().GetValues()
+		for i := 0; i < len(as) && i < len(bs); i++ {
+			if c := compareValues(as[i], bs[i]); c != 0 {
+				return c
+			}
+		}
+		return compareInts(int64(len(as)), int64(len(bs)))
+	case *pb.Value_MapValue:
+		am, bm := av.MapValue.GetFields(), b.GetMapValue().GetFields()
+		ak, bk := sortedKeys(am), sortedKeys(bm)
+		for i := 0; i < len(ak) && i < len(bk); i++ {
+			if c := strings.Compare(ak[i], bk[i]); c != 0 {
+				return c
+			}
+			if c := compareValues(am[ak[i]], bm[bk[i]]); c != 0 {
+				return c
+			}
+		}
+		return compareInts(int64(len(ak)), int64(len(bk)))
+	}
+
+	return 0
+}
+
+func compareInts(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func boolInt(b bool) int64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+func doubleValue(f float64) *pb.Value {
+	return &pb.Value{ValueType: &pb.Value_DoubleValue{DoubleValue: f}}
+}
+
+func sortedKeys(m map[string]*pb.Value) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// TestFakeFirestore checks the fake implements the semantics the companion relies on
+func TestFakeFirestore(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+	col := s.FirestoreClient.Collection("items")
+
+	type item struct {
+		N    int      `firestore:"n"`
+		Tags []string `firestore:"tags,omitempty"`
+	}
+
+	for i := 0; i < 5; i++ {
+		if _, err := col.Doc(fmt.Sprint("i", i)).Create(ctx, &item{N: i}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := col.Doc("i0").Create(ctx, &item{}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("create of an existing document = %v, want AlreadyExists", err)
+	}
+	if _, err := col.Doc("missing").Update(ctx, []firestore.Update{{Path: "n", Value: 1}}); status.Code(err) != codes.NotFound {
+		t.Errorf("update of a missing document = %v, want NotFound", err)
+	}
+
+	snap, err := col.Doc("i1").Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := col.Doc("i1").Update(ctx, []firestore.Update{
+		{Path: "n", Value: firestore.Increment(10)},
+		{Path: "tags", Value: firestore.ArrayUnion("a", "b")},
+	}, firestore.LastUpdateTime(snap.UpdateTime)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := col.Doc("i1").Update(ctx, []firestore.Update{{Path: "n", Value: 0}}, firestore.LastUpdateTime(snap.UpdateTime)); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("update of a changed document = %v, want FailedPrecondition", err)
+	}
+
+	docs, err := col.Where("n", ">=", 2).OrderBy("n", firestore.Desc).Limit(2).Documents(ctx).GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, doc := range docs {
+		ids = append(ids, doc.Ref.ID)
+	}
+	if strings.Join(ids, ",") != "i1,i4" {
+		t.Errorf("query = %v, want i1,i4", ids)
+	}
+
+	docs, err = col.Where("tags", "array-contains", "a").Documents(ctx).GetAll()
+	if err != nil || len(docs) != 1 || docs[0].Ref.ID != "i1" {
+		t.Errorf("array-contains query = %v, %v, want i1", docs, err)
+	}
+
+	docs, err = col.OrderBy("n", firestore.Asc).StartAfter(3).Documents(ctx).GetAll()
+	if err != nil || len(docs) != 2 {
+		t.Errorf("query after 3 = %d documents, %v, want 2", len(docs), err)
+	}
+}
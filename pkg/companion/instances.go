@@ -0,0 +1,369 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/api/iterator"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+	"time"
+)
+
+// instanceDoc returns the document of the instance
+func (s *Service) instanceDoc(instanceID string) *firestore.DocumentRef {
+	return s.FirestoreClient.Doc(s.CollectionPrefix + instancesCollection + "/" + instanceID)
+}
+
+func (s *Service) GetInstance(ctx context.Context, r *v1.GetInstanceRequest) (*v1.AppInstance, error) {
+	if err := r.Validate(); err != nil {
+		return &v1.AppInstance{}, err
+	}
+
+	snap, err := s.instanceDoc(r.InstanceId).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return &v1.AppInstance{}, status.Errorf(codes.NotFound, "instance %q not found", r.InstanceId)
+	} else if err != nil {
+		return &v1.AppInstance{}, err
+	}
+
+	return instanceFromSnapshot(snap)
+}
+
+func (s *Service) UpdateLabels(ctx context.Context, r *v1.UpdateLabelsRequest) (*empty.Empty, error) {
+	if err := r.Validate(); err != nil {
+		return &empty.Empty{}, err
+	}
+
+	now := time.Now()
+	updates := []firestore.Update{
+		{Path: "updatedAt", Value: now},
+	}
+
+	if r.Replace {
+		if len(r.Remove) > 0 {
+			return &empty.Empty{}, status.Error(codes.InvalidArgument, "remove can't be used together with replace")
+		}
+
+		labels := r.Set
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		updates = append(updates, firestore.Update{Path: "labels", Value: labels})
+	} else {
+		// each label is updated through its own field path, leaving other labels intact
+		for k, v := range r.Set {
+			updates = append(updates, firestore.Update{FieldPath: firestore.FieldPath{"labels", k}, Value: v})
+		}
+		for _, k := range r.Remove {
+			if _, ok := r.Set[k]; ok {
+				return &empty.Empty{}, status.Errorf(codes.InvalidArgument, "label %q can't be both set and removed", k)
+			}
+			updates = append(updates, firestore.Update{FieldPath: firestore.FieldPath{"labels", k}, Value: firestore.Delete})
+		}
+	}
+
+	_, err := s.instanceDoc(r.InstanceId).Update(ctx, updates)
+	if status.Code(err) == codes.NotFound {
+		return &empty.Empty{}, status.Errorf(codes.NotFound, "instance %q not found", r.InstanceId)
+	}
+
+	return &empty.Empty{}, err
+}
+
+func (s *Service) ListInstances(ctx context.Context, r *v1.ListInstancesRequest) (*v1.AppInstanceList, error) {
+	if err := r.Validate(); err != nil {
+		return &v1.AppInstanceList{}, err
+	}
+
+	col := s.FirestoreClient.Collection(s.CollectionPrefix + instancesCollection)
+	q := col.Query
+
+	if r.Ref != "" {
+		q = q.Where("ref", "==", r.Ref)
+	}
+	for k, v := range r.Labels {
+		q = q.WherePath(firestore.FieldPath{"labels", k}, "==", v)
+	}
+	if r.Platform != v1.Platform_PLATFORM_UNSPECIFIED {
+		q = q.Where("platform", "==", r.Platform)
+	}
+	if r.AppVersion != "" {
+		q = q.Where("appVersion", "==", r.AppVersion)
+	}
+
+	if r.LastSeenAfter != nil || r.LastSeenBefore != nil {
+		if r.LastSeenAfter != nil {
+			after, err := ptypes.Timestamp(r.LastSeenAfter)
+			if err != nil {
+				return &v1.AppInstanceList{}, status.Errorf(codes.InvalidArgument, "invalid last_seen_after: %v", err)
+			}
+			q = q.Where("lastSeenAt", ">=", after)
+		}
+		if r.LastSeenBefore != nil {
+			before, err := ptypes.Timestamp(r.LastSeenBefore)
+			if err != nil {
+				return &v1.AppInstanceList{}, status.Errorf(codes.InvalidArgument, "invalid last_seen_before: %v", err)
+			}
+			q = q.Where("lastSeenAt", "<=", before)
+		}
+		q = q.OrderBy("lastSeenAt", firestore.Desc)
+	} else if r.Token == v1.ListInstancesRequest_TOKEN_PRESENT {
+		// the inequality on the token requires the token to be ordered first
+		q = q.Where("token", ">", "").
+			OrderBy("token", firestore.Asc).
+			OrderBy(firestore.DocumentID, firestore.Asc)
+	} else {
+		q = q.OrderBy(firestore.DocumentID, firestore.Asc)
+	}
+
+	// the page token is the id of the last instance of the previous page
+	if r.PageToken != "" {
+		last, err := col.Doc(r.PageToken).Get(ctx)
+		if err != nil {
+			return &v1.AppInstanceList{}, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		q = q.StartAfter(last)
+	}
+
+	// the token absence can't be queried for instances without the token field, and the
+	// token presence can't be queried together with the last seen range, so these are
+	// filtered while reading the documents. The number of scanned documents is limited
+	// by the maxScannedInstances, a shorter page is returned with the token of the last
+	// scanned document if the limit is reached
+	pageSize := pageSize(r.PageSize)
+	list := &v1.AppInstanceList{}
+
+	it := q.Documents(ctx)
+	defer it.Stop()
+	// last is the id of the last scanned instance
+	var last string
+	for scanned := 0; len(list.Instances) < pageSize; scanned++ {
+		if scanned == maxScannedInstances {
+			list.NextPageToken = last
+			return list, nil
+		}
+
+		snap, err := it.Next()
+		if err == iterator.Done {
+			return list, nil
+		} else if err != nil {
+			return &v1.AppInstanceList{}, err
+		}
+
+		i, err := instanceFromSnapshot(snap)
+		if err != nil {
+			return &v1.AppInstanceList{}, err
+		}
+		last = i.InstanceId
+
+		if !matchesTokenFilter(i, r.Token) {
+			continue
+		}
+
+		list.Instances = append(list.Instances, i)
+	}
+
+	list.NextPageToken = last
+
+	return list, nil
+}
+
+// instanceFromSnapshot returns the instance stored in the document
+func instanceFromSnapshot(snap *firestore.DocumentSnapshot) (*v1.AppInstance, error) {
+	i := &v1.AppInstance{}
+	if err := snap.DataTo(i); err != nil {
+		return nil, err
+	}
+	i.InstanceId = snap.Ref.ID
+
+	return i, nil
+}
+
+// matchesTokenFilter returns true if the token of the instance satisfies the filter
+func matchesTokenFilter(i *v1.AppInstance, f v1.ListInstancesRequest_TokenFilter) bool {
+	switch f {
+	case v1.ListInstancesRequest_TOKEN_PRESENT:
+		return i.Token != ""
+	case v1.ListInstancesRequest_TOKEN_ABSENT:
+		return i.Token == ""
+	default:
+		return true
+	}
+}
+
+func (s *Service) ListUserDevices(ctx context.Context, r *v1.ListUserDevicesRequest) (*v1.AppInstanceList, error) {
+	if err := r.Validate(); err != nil {
+		return &v1.AppInstanceList{}, err
+	}
+
+	instances, err := s.userInstances(ctx, r.Ref)
+	if err != nil {
+		return &v1.AppInstanceList{}, err
+	}
+
+	// all instances of the ref are read anyway to order them by their last seen time,
+	// the page token is the id of the last instance of the previous page
+	start := 0
+	if r.PageToken != "" {
+		start = -1
+		for n, i := range instances {
+			if i.InstanceId == r.PageToken {
+				start = n + 1
+				break
+			}
+		}
+		if start < 0 {
+			return &v1.AppInstanceList{}, status.Errorf(codes.InvalidArgument, "invalid page token: instance %q not found", r.PageToken)
+		}
+	}
+
+	list := &v1.AppInstanceList{Instances: instances[start:]}
+	if pageSize := pageSize(r.PageSize); len(list.Instances) > pageSize {
+		list.Instances = list.Instances[:pageSize]
+		list.NextPageToken = list.Instances[pageSize-1].InstanceId
+	}
+
+	return list, nil
+}
+
+func (s *Service) RemoveUser(ctx context.Context, r *v1.RemoveUserRequest) (*empty.Empty, error) {
+	if err := r.Validate(); err != nil {
+		return &empty.Empty{}, err
+	}
+
+	instances, err := s.userInstances(ctx, r.Ref)
+	if err != nil {
+		return &empty.Empty{}, err
+	}
+
+	// the instances are removed last, as the data of the user is found by their tokens.
+	// A failed call can be retried until the instances are gone
+	if err := s.removeUserData(ctx, r.Ref, instances); err != nil {
+		return &empty.Empty{}, err
+	}
+
+	// preferences are removed with the user, so a new user with the same ref consents to all
+	if doc := s.preferencesDoc(r.Ref); doc != nil {
+		if _, err := doc.Delete(ctx); err != nil {
+			return &empty.Empty{}, err
+		}
+	}
+
+	if err := s.removeInstances(ctx, instances); err != nil {
+		return &empty.Empty{}, err
+	}
+
+	s.Info("User was removed", zap.String("ref", r.Ref), zap.Int("instances", len(instances)))
+
+	return &empty.Empty{}, nil
+}
+
+// userInstances returns all instances of the ref, most recently seen first.
+// The number of instances of a single ref is expected to be small
+func (s *Service) userInstances(ctx context.Context, ref string) ([]*v1.AppInstance, error) {
+	docs, err := s.FirestoreClient.Collection(s.CollectionPrefix+instancesCollection).
+		Where("ref", "==", ref).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return nil, err
+	}
+
+	instances := make([]*v1.AppInstance, 0, len(docs))
+	for _, doc := range docs {
+		i, err := instanceFromSnapshot(doc)
+		if err != nil {
+			return nil, err
+		}
+		instances = append(instances, i)
+	}
+
+	sort.SliceStable(instances, func(a, b int) bool {
+		return lastSeen(instances[a]).After(lastSeen(instances[b]))
+	})
+
+	return instances, nil
+}
+
+// refTokens returns tokens of all instances of the ref that have a token
+func (s *Service) refTokens(ctx context.Context, ref string) ([]string, error) {
+	instances, err := s.userInstances(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []string
+	for _, i := range instances {
+		if i.Token != "" {
+			tokens = append(tokens, i.Token)
+		}
+	}
+
+	return tokens, nil
+}
+
+// evictDevices removes the least recently seen instances of the ref exceeding
+// the MaxDevicesPerRef
+func (s *Service) evictDevices(ctx context.Context, ref string) error {
+	if ref == "" || s.MaxDevicesPerRef <= 0 {
+		return nil
+	}
+
+	instances, err := s.userInstances(ctx, ref)
+	if err != nil {
+		return err
+	}
+	if len(instances) <= s.MaxDevicesPerRef {
+		return nil
+	}
+
+	evicted := instances[s.MaxDevicesPerRef:]
+	if err := s.removeInstances(ctx, evicted); err != nil {
+		return err
+	}
+
+	for _, i := range evicted {
+		s.Info("Instance was evicted",
+			zap.String("ref", ref),
+			zap.String("instanceID", i.InstanceId),
+		)
+	}
+
+	return nil
+}
+
+// removeInstances deletes the instances in batches
+func (s *Service) removeInstances(ctx context.Context, instances []*v1.AppInstance) error {
+	for start := 0; start < len(instances); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(instances) {
+			end = len(instances)
+		}
+
+		batch := s.FirestoreClient.Batch()
+		for _, i := range instances[start:end] {
+			batch.Delete(s.instanceDoc(i.InstanceId))
+		}
+
+		if _, err := batch.Commit(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lastSeen returns the last seen time of the instance, or the zero time if unknown
+func lastSeen(i *v1.AppInstance) time.Time {
+	if i.LastSeenAt == nil {
+		return time.Time{}
+	}
+
+	t, _ := ptypes.Timestamp(i.LastSeenAt)
+	return t
+}
@@ -0,0 +1,314 @@
+package companion
+
+import (
+	"context"
+	"fmt"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	pb "google.golang.org/genproto/googleapis/firestore/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// instanceIDs returns the ids of the instances
+func instanceIDs(instances []*v1.AppInstance) []string {
+	ids := []string{}
+	for _, i := range instances {
+		ids = append(ids, i.InstanceId)
+	}
+	return ids
+}
+
+func TestListInstances(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	for _, i := range []*v1.AppInstance{
+		{InstanceId: "instance1", Token: "t3", Ref: "u1", Labels: map[string]string{"beta": "true"}},
+		{InstanceId: "instance2", Ref: "u1"},
+		{InstanceId: "instance3", Token: "t1", Ref: "u2"},
+		{InstanceId: "instance4", Token: "t2", Ref: "u2"},
+	} {
+		if _, err := s.PutInstance(ctx, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name string
+		r    *v1.ListInstancesRequest
+		want []string
+	}{
+		{name: "all", r: &v1.ListInstancesRequest{}, want: []string{"instance1", "instance2", "instance3", "instance4"}},
+		{name: "ref", r: &v1.ListInstancesRequest{Ref: "u1"}, want: []string{"instance1", "instance2"}},
+		{name: "labels", r: &v1.ListInstancesRequest{Labels: map[string]string{"beta": "true"}}, want: []string{"instance1"}},
+		{
+			// instances with a token are ordered by the token
+			name: "token present",
+			r:    &v1.ListInstancesRequest{Token: v1.ListInstancesRequest_TOKEN_PRESENT},
+			want: []string{"instance3", "instance4", "instance1"},
+		},
+		{
+			name: "token absent",
+			r:    &v1.ListInstancesRequest{Token: v1.ListInstancesRequest_TOKEN_ABSENT},
+			want: []string{"instance2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// pages of a single instance walk all of the pagination
+			tt.r.PageSize = 1
+
+			got := []string{}
+			for {
+				list, err := s.ListInstances(ctx, tt.r)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, instanceIDs(list.Instances)...)
+
+				if list.NextPageToken == "" {
+					break
+				}
+				tt.r.PageToken = list.NextPageToken
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ListInstances = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := s.ListInstances(ctx, &v1.ListInstancesRequest{PageToken: "unknown1"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListInstances with an unknown page token = %v, want InvalidArgument", err)
+	}
+}
+
+func TestListInstancesScanLimit(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	// no instance matches the in-memory filter until the last one
+	batch := s.FirestoreClient.Batch()
+	for n := 0; n < maxScannedInstances; n++ {
+		batch.Set(s.instanceDoc(fmt.Sprintf("instance%04d", n)), &v1.AppInstance{Token: "t"})
+	}
+	batch.Set(s.instanceDoc("last"), &v1.AppInstance{})
+	if _, err := batch.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &v1.ListInstancesRequest{Token: v1.ListInstancesRequest_TOKEN_ABSENT}
+	list, err := s.ListInstances(ctx, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Instances) != 0 || list.NextPageToken != fmt.Sprintf("instance%04d", maxScannedInstances-1) {
+		t.Fatalf("ListInstances = %v, %q, want an empty page at the last scanned instance", list.Instances, list.NextPageToken)
+	}
+
+	r.PageToken = list.NextPageToken
+	list, err = s.ListInstances(ctx, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := instanceIDs(list.Instances); !reflect.DeepEqual(ids, []string{"last"}) || list.NextPageToken != "" {
+		t.Errorf("next page = %v, %q, want the last instance", ids, list.NextPageToken)
+	}
+}
+
+func TestGetInstance(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	if _, err := s.PutInstance(ctx, &v1.AppInstance{InstanceId: "instance1", Token: "t1", Ref: "u1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	i, err := s.GetInstance(ctx, &v1.GetInstanceRequest{InstanceId: "instance1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i.Token != "t1" || i.Ref != "u1" || i.CreatedAt == nil || i.LastSeenAt == nil {
+		t.Errorf("GetInstance = %v, want the stamped instance", i)
+	}
+
+	if _, err := s.GetInstance(ctx, &v1.GetInstanceRequest{InstanceId: "instance2"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetInstance of an unknown instance = %v, want NotFound", err)
+	}
+}
+
+func TestListUserDevices(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	// instances are put in the order they're seen
+	for _, id := range []string{"instance3", "instance1", "instance2"} {
+		if _, err := s.PutInstance(ctx, &v1.AppInstance{InstanceId: id, Token: "t-" + id, Ref: "u1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := s.PutInstance(ctx, &v1.AppInstance{InstanceId: "instance4", Ref: "u2"}); err != nil {
+		t.Fatal(err)
+	}
+
+	r := &v1.ListUserDevicesRequest{Ref: "u1", PageSize: 2}
+	list, err := s.ListUserDevices(ctx, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := instanceIDs(list.Instances); !reflect.DeepEqual(ids, []string{"instance2", "instance1"}) || list.NextPageToken != "instance1" {
+		t.Fatalf("ListUserDevices = %v, %q, want the most recently seen page", ids, list.NextPageToken)
+	}
+
+	r.PageToken = list.NextPageToken
+	list, err = s.ListUserDevices(ctx, r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := instanceIDs(list.Instances); !reflect.DeepEqual(ids, []string{"instance3"}) || list.NextPageToken != "" {
+		t.Errorf("next page = %v, %q, want the last instance", ids, list.NextPageToken)
+	}
+
+	r.PageToken = "instance4"
+	if _, err := s.ListUserDevices(ctx, r); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListUserDevices with an instance of another ref = %v, want InvalidArgument", err)
+	}
+}
+
+func TestRemoveUser(t *testing.T) {
+	s, f := newTestService(t)
+	ctx := context.Background()
+
+	for _, i := range []*v1.AppInstance{
+		{InstanceId: "instance1", Token: "t1", Ref: "u1"},
+		{InstanceId: "instance2", Token: "t2", Ref: "u1"},
+		{InstanceId: "instance3", Token: "t3", Ref: "u2"},
+	} {
+		if _, err := s.PutInstance(ctx, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	notifications := s.FirestoreClient.Collection(s.CollectionPrefix + notificationsCollection)
+	for _, data := range []map[string]interface{}{
+		{"ref": "u1"},
+		{"instanceIds": []string{"instance2"}},
+		{"ref": "u2"},
+	} {
+		if _, _, err := notifications.Add(ctx, data); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := s.preferencesDoc("u1").Set(ctx, map[string]interface{}{"optOut": []string{"news"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the call fails while removing the notifications, the instances are kept for the retry
+	f.failCommit = func(r *pb.CommitRequest) error {
+		for _, w := range r.Writes {
+			if strings.Contains(w.GetDelete(), notificationsCollection) {
+				return status.Error(codes.PermissionDenied, "denied")
+			}
+		}
+		return nil
+	}
+	if _, err := s.RemoveUser(ctx, &v1.RemoveUserRequest{Ref: "u1"}); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("RemoveUser = %v, want PermissionDenied", err)
+	}
+	if n := f.count(instancesCollection); n != 3 {
+		t.Errorf("%d instances are stored, want all kept", n)
+	}
+
+	f.failCommit = nil
+	if _, err := s.RemoveUser(ctx, &v1.RemoveUserRequest{Ref: "u1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	for collection, want := range map[string]int{
+		instancesCollection:     1,
+		notificationsCollection: 1,
+		preferencesCollection:   0,
+	} {
+		if n := f.count(collection); n != want {
+			t.Errorf("%d documents of %s are stored, want %d", n, collection, want)
+		}
+	}
+}
+
+func TestUpdateLabels(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	if _, err := s.PutInstance(ctx, &v1.AppInstance{
+		InstanceId: "instance1",
+		Token:      "t1",
+		Labels:     map[string]string{"beta": "true", "plan": "trial", "lang": "en"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		r    *v1.UpdateLabelsRequest
+		want map[string]string
+		code codes.Code
+	}{
+		{
+			name: "set and remove",
+			r:    &v1.UpdateLabelsRequest{Set: map[string]string{"plan": "paid", "os": "ios"}, Remove: []string{"beta"}},
+			want: map[string]string{"plan": "paid", "os": "ios", "lang": "en"},
+		},
+		{
+			name: "set and remove a label",
+			r:    &v1.UpdateLabelsRequest{Set: map[string]string{"plan": "free"}, Remove: []string{"plan"}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "replace and remove",
+			r:    &v1.UpdateLabelsRequest{Replace: true, Remove: []string{"lang"}},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "replace",
+			r:    &v1.UpdateLabelsRequest{Replace: true, Set: map[string]string{"plan": "paid"}},
+			want: map[string]string{"plan": "paid"},
+		},
+		{
+			name: "replace with nothing",
+			r:    &v1.UpdateLabelsRequest{Replace: true},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt.r.InstanceId = "instance1"
+		_, err := s.UpdateLabels(ctx, tt.r)
+		if status.Code(err) != tt.code {
+			t.Fatalf("%s: UpdateLabels = %v, want %v", tt.name, err, tt.code)
+		}
+		if err != nil {
+			continue
+		}
+
+		i, err := s.GetInstance(ctx, &v1.GetInstanceRequest{InstanceId: "instance1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(i.Labels) != 0 || len(tt.want) != 0 {
+			if !reflect.DeepEqual(i.Labels, tt.want) {
+				t.Errorf("%s: labels = %v, want %v", tt.name, i.Labels, tt.want)
+			}
+		}
+		if i.Token != "t1" {
+			t.Errorf("%s: token = %q, want other fields kept", tt.name, i.Token)
+		}
+	}
+
+	_, err := s.UpdateLabels(ctx, &v1.UpdateLabelsRequest{InstanceId: "instance2", Set: map[string]string{"plan": "paid"}})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("UpdateLabels of an unknown instance = %v, want NotFound", err)
+	}
+}
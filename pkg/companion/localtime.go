@@ -0,0 +1,199 @@
+package companion
+
+import (
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+const (
+	// localDateLayout is the layout of the LocalTime date
+	localDateLayout = "2006-01-02"
+)
+
+// validateTimezone returns an InvalidArgument error if the timezone of the instance
+// or of its quiet hours is not a known IANA timezone
+func validateTimezone(i *v1.AppInstance) error {
+	for _, tz := range []string{i.Timezone, i.QuietHours.GetTimezone()} {
+		if tz == "" {
+			continue
+		}
+
+		if _, err := time.LoadLocation(tz); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid timezone %q: %v", tz, err)
+		}
+	}
+
+	return nil
+}
+
+// scheduleLocalTime schedules the message for each timezone of the instances of the audience
+// at the local time. Each timezone is scheduled in batches of tokens. Timezones in which
+// the explicit date already passed are skipped, as the message would be sent late
+func (s *Service) scheduleLocalTime(ctx context.Context, m *v1.MulticastMessage, expr string) error {
+	lt := m.LocalTime
+	if expr == "" {
+		return status.Error(codes.InvalidArgument, "local_time requires the audience or segment_id")
+	}
+
+	defaultLoc := time.UTC
+	if lt.DefaultTimezone != "" {
+		loc, err := time.LoadLocation(lt.DefaultTimezone)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid default_timezone %q: %v", lt.DefaultTimezone, err)
+		}
+		defaultLoc = loc
+	}
+
+	var date time.Time
+	if lt.Date != "" {
+		d, err := time.Parse(localDateLayout, lt.Date)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid date %q: %v", lt.Date, err)
+		}
+		date = d
+	}
+
+	e, err := parseAudience(expr)
+	if err != nil {
+		return err
+	}
+
+	// tokens are grouped by the timezones of their instances
+	timezones := map[string][]string{}
+	seen := map[string]bool{}
+	err = s.matchAudience(ctx, e, func(i *v1.AppInstance) error {
+		if i.Token == "" || seen[i.Token] {
+			return nil
+		}
+		seen[i.Token] = true
+
+		tz := i.Timezone
+		if _, err := time.LoadLocation(tz); tz == "" || err != nil {
+			tz = defaultLoc.String()
+		}
+		timezones[tz] = append(timezones[tz], i.Token)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(timezones) == 0 {
+		return status.Error(codes.NotFound, "no instances with a token match the audience")
+	}
+
+	now := time.Now()
+	sendAts := map[string]time.Time{}
+	for tz, tokens := range timezones {
+		loc, _ := time.LoadLocation(tz)
+
+		sendAt := nextLocalTime(now, date, int(lt.Hour), int(lt.Minute), loc)
+		if !sendAt.After(now) {
+			s.Warn("Local time has passed, timezone was skipped",
+				zap.String("timezone", tz),
+				zap.Time("sendAt", sendAt),
+				zap.Int("tokens", len(tokens)),
+			)
+			continue
+		}
+		sendAts[tz] = sendAt
+	}
+
+	if len(sendAts) == 0 {
+		return status.Errorf(codes.InvalidArgument, "local time %s %02d:%02d has passed in all timezones of the audience",
+			lt.Date, lt.Hour, lt.Minute)
+	}
+
+	for tz, sendAt := range sendAts {
+		tokens := timezones[tz]
+
+		ts, err := ptypes.TimestampProto(sendAt)
+		if err != nil {
+			return err
+		}
+
+		for batch, start := 0, 0; start < len(tokens); batch, start = batch+1, start+maxBatchSize {
+			end := start + maxBatchSize
+			if end > len(tokens) {
+				end = len(tokens)
+			}
+
+			scheduled := proto.Clone(m).(*v1.MulticastMessage)
+			scheduled.Tokens = tokens[start:end]
+			scheduled.Audience, scheduled.SegmentId = "", ""
+			scheduled.LocalTime, scheduled.SendAt = nil, ts
+
+			// each batch is identified by the idempotency key to be scheduled only once
+			if m.IdempotencyKey != "" {
+				scheduled.IdempotencyKey = fmt.Sprintf("%s:%s:%d", m.IdempotencyKey, tz, batch)
+			}
+
+			if err := s.scheduleMulticastMessage(ctx, scheduled); err != nil {
+				return err
+			}
+		}
+
+		s.Debug("Local time was scheduled",
+			zap.String("timezone", tz),
+			zap.Time("sendAt", sendAt),
+			zap.Int("tokens", len(tokens)),
+		)
+	}
+
+	return nil
+}
+
+// nextLocalTime returns the instant of the wall-clock time in the location on the date.
+// If the date is zero, the next occurrence of the wall-clock time after now is returned
+func nextLocalTime(now, date time.Time, hour, minute int, loc *time.Location) time.Time {
+	if !date.IsZero() {
+		return localInstant(date.Year(), date.Month(), date.Day(), hour, minute, loc)
+	}
+
+	today := now.In(loc)
+	t := localInstant(today.Year(), today.Month(), today.Day(), hour, minute, loc)
+	if t.After(now) {
+		return t
+	}
+
+	tomorrow := today.AddDate(0, 0, 1)
+	return localInstant(tomorrow.Year(), tomorrow.Month(), tomorrow.Day(), hour, minute, loc)
+}
+
+// localInstant returns the instant of the wall-clock time in the location. Wall-clock times
+// repeated by a daylight saving transition return the first occurrence. Wall-clock times
+// skipped by the transition are shifted forward by the length of the transition
+func localInstant(year int, month time.Month, day, hour, minute int, loc *time.Location) time.Time {
+	wall := time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+
+	// the offsets used in the location around the wall-clock time
+	_, before := wall.Add(-24 * time.Hour).In(loc).Zone()
+	_, after := wall.Add(24 * time.Hour).In(loc).Zone()
+
+	var first time.Time
+	for _, offset := range []int{before, after} {
+		t := wall.Add(-time.Duration(offset) * time.Second)
+
+		local := t.In(loc)
+		if local.Hour() != hour || local.Minute() != minute || local.Day() != day {
+			continue
+		}
+		if first.IsZero() || t.Before(first) {
+			first = t
+		}
+	}
+
+	if !first.IsZero() {
+		return first
+	}
+
+	// the wall-clock time doesn't exist, the offset before the transition shifts it forward
+	return wall.Add(-time.Duration(before) * time.Second)
+}
@@ -0,0 +1,203 @@
+package companion
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("cannot load %s: %v", name, err)
+	}
+
+	return loc
+}
+
+func TestLocalInstant(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name         string
+		month        time.Month
+		day          int
+		hour, minute int
+		want         time.Time
+	}{
+		{
+			name:  "standard time",
+			month: time.January, day: 15, hour: 9,
+			want: time.Date(2020, time.January, 15, 14, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "daylight saving time",
+			month: time.June, day: 1, hour: 9,
+			want: time.Date(2020, time.June, 1, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "skipped by the spring transition is shifted forward",
+			month: time.March, day: 8, hour: 2, minute: 30,
+			want: time.Date(2020, time.March, 8, 7, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "after the spring transition",
+			month: time.March, day: 8, hour: 3, minute: 30,
+			want: time.Date(2020, time.March, 8, 7, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "repeated by the fall transition is the first occurrence",
+			month: time.November, day: 1, hour: 1, minute: 30,
+			want: time.Date(2020, time.November, 1, 5, 30, 0, 0, time.UTC),
+		},
+		{
+			name:  "after the fall transition",
+			month: time.November, day: 1, hour: 2, minute: 30,
+			want: time.Date(2020, time.November, 1, 7, 30, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := localInstant(2020, tt.month, tt.day, tt.hour, tt.minute, newYork)
+			if !got.Equal(tt.want) {
+				t.Errorf("localInstant = %s, want %s", got.UTC(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNextLocalTime(t *testing.T) {
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name string
+		now  time.Time
+		date time.Time
+		want time.Time
+	}{
+		{
+			name: "later today",
+			now:  time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC),
+			want: time.Date(2020, time.June, 1, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "passed today",
+			now:  time.Date(2020, time.June, 1, 13, 0, 0, 0, time.UTC),
+			want: time.Date(2020, time.June, 2, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "tomorrow across the spring transition",
+			now:  time.Date(2020, time.March, 7, 15, 0, 0, 0, time.UTC),
+			want: time.Date(2020, time.March, 8, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "local date differs from the UTC date",
+			now:  time.Date(2020, time.June, 2, 2, 0, 0, 0, time.UTC),
+			want: time.Date(2020, time.June, 2, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "date",
+			now:  time.Date(2020, time.June, 1, 12, 0, 0, 0, time.UTC),
+			date: time.Date(2020, time.December, 24, 0, 0, 0, 0, time.UTC),
+			want: time.Date(2020, time.December, 24, 14, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := nextLocalTime(tt.now, tt.date, 9, 0, newYork)
+			if !got.Equal(tt.want) {
+				t.Errorf("nextLocalTime = %s, want %s", got.UTC(), tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleLocalTime(t *testing.T) {
+	ctx := context.Background()
+	honolulu := mustLoadLocation(t, "Pacific/Honolulu")
+
+	// the local midnight of the next day in Honolulu already passed in Kiritimati
+	tomorrow := time.Now().In(honolulu).AddDate(0, 0, 1).Format(localDateLayout)
+
+	tests := []struct {
+		name string
+		lt   *v1.LocalTime
+		// tokens are the scheduled tokens
+		tokens []string
+		code   codes.Code
+	}{
+		{name: "next occurrence", lt: &v1.LocalTime{Hour: 9}, tokens: []string{"t1", "t2", "t3"}},
+		{name: "date passed in a timezone", lt: &v1.LocalTime{Date: tomorrow}, tokens: []string{"t1", "t3"}},
+		{name: "date passed", lt: &v1.LocalTime{Hour: 9, Date: "2000-01-01"}, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newTestService(t)
+			for _, i := range []*v1.AppInstance{
+				{InstanceId: "instance1", Token: "t1", Ref: "u1", Timezone: "Pacific/Honolulu"},
+				{InstanceId: "instance2", Token: "t2", Ref: "u1", Timezone: "Pacific/Kiritimati"},
+				{InstanceId: "instance3", Token: "t3", Ref: "u1"},
+			} {
+				if _, err := s.PutInstance(ctx, i); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			tt.lt.DefaultTimezone = "Pacific/Honolulu"
+			_, err := s.SendMulticast(ctx, &v1.SendMulticastRequest{Message: &v1.MulticastMessage{
+				TemplateId: "hello",
+				Audience:   `ref = "u1"`,
+				LocalTime:  tt.lt,
+			}})
+			if status.Code(err) != tt.code {
+				t.Fatalf("SendMulticast = %v, want %v", err, tt.code)
+			}
+
+			list, err := s.ListScheduled(ctx, &v1.ListScheduledRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var tokens []string
+			for _, n := range list.Notifications {
+				if sendAt, _ := ptypes.Timestamp(n.SendAt); !sendAt.After(time.Now()) {
+					t.Errorf("scheduled %v at %s, want the future", n.Tokens, sendAt)
+				}
+				tokens = append(tokens, n.Tokens...)
+			}
+			sort.Strings(tokens)
+			if !reflect.DeepEqual(tokens, tt.tokens) {
+				t.Errorf("scheduled tokens = %v, want %v", tokens, tt.tokens)
+			}
+		})
+	}
+}
+
+func TestSendMulticastLocalTimeSendAt(t *testing.T) {
+	s, f := newTestService(t)
+
+	sendAt, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	_, err := s.SendMulticast(context.Background(), &v1.SendMulticastRequest{Message: &v1.MulticastMessage{
+		TemplateId: "hello",
+		Audience:   `ref = "u1"`,
+		LocalTime:  &v1.LocalTime{Hour: 9},
+		SendAt:     sendAt,
+	}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("SendMulticast = %v, want InvalidArgument", err)
+	}
+	if n := f.count(scheduledCollection); n != 0 {
+		t.Errorf("%d notifications are scheduled, want none", n)
+	}
+}
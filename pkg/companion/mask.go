@@ -0,0 +1,122 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// instanceMaskFields maps the fields of the AppInstance writable by the update mask
+// to their stored names
+var instanceMaskFields = map[string]string{
+	"token":       "token",
+	"ref":         "ref",
+	"labels":      "labels",
+	"platform":    "platform",
+	"app_version": "appVersion",
+	"os_version":  "osVersion",
+	"sdk_version": "sdkVersion",
+	"timezone":    "timezone",
+	"quiet_hours": "quietHours",
+}
+
+// validateInstanceMask returns an InvalidArgument error if the mask contains
+// fields that can't be written
+func validateInstanceMask(mask *field_mask.FieldMask) error {
+	if mask == nil {
+		return nil
+	}
+
+	if len(mask.Paths) == 0 {
+		return status.Error(codes.InvalidArgument, "update_mask must contain at least one field")
+	}
+
+	for _, p := range mask.Paths {
+		if _, ok := instanceMaskFields[p]; !ok {
+			return status.Errorf(codes.InvalidArgument, "field %q can't be updated", p)
+		}
+	}
+
+	return nil
+}
+
+// masks returns true if the mask contains the field
+func masks(mask *field_mask.FieldMask, field string) bool {
+	for _, p := range mask.GetPaths() {
+		if p == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sentInstanceFields returns the mask fields set on the instance, so writes without
+// an update mask keep the stored values of the fields that were not sent
+func sentInstanceFields(i *v1.AppInstance) []string {
+	sent := map[string]bool{
+		"token":       i.Token != "",
+		"ref":         i.Ref != "",
+		"labels":      len(i.Labels) > 0,
+		"platform":    i.Platform != v1.Platform_PLATFORM_UNSPECIFIED,
+		"app_version": i.AppVersion != "",
+		"os_version":  i.OsVersion != "",
+		"sdk_version": i.SdkVersion != "",
+		"timezone":    i.Timezone != "",
+		"quiet_hours": i.QuietHours != nil,
+	}
+
+	var fields []string
+	for field, ok := range sent {
+		if ok {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+// maskedInstance returns the masked fields of the instance with the server-side fields
+// and their paths. Values are returned even if empty, so masked fields can be cleared
+func maskedInstance(i *v1.AppInstance, mask []string) (map[string]interface{}, []firestore.FieldPath) {
+	values := map[string]interface{}{
+		"token":      i.Token,
+		"ref":        i.Ref,
+		"labels":     i.Labels,
+		"platform":   i.Platform,
+		"appVersion": i.AppVersion,
+		"osVersion":  i.OsVersion,
+		"sdkVersion": i.SdkVersion,
+		"timezone":   i.Timezone,
+		"quietHours": i.QuietHours,
+	}
+	if i.Labels == nil {
+		values["labels"] = map[string]string{}
+	}
+
+	data := map[string]interface{}{
+		"instanceID": i.InstanceId,
+		"updatedAt":  i.UpdatedAt,
+		"lastSeenAt": i.LastSeenAt,
+	}
+	if i.CreatedAt != nil {
+		data["createdAt"] = i.CreatedAt
+	}
+	if i.TokenUpdatedAt != nil {
+		data["tokenUpdatedAt"] = i.TokenUpdatedAt
+	}
+
+	for _, p := range mask {
+		name := instanceMaskFields[p]
+		data[name] = values[name]
+	}
+
+	paths := make([]firestore.FieldPath, 0, len(data))
+	for name := range data {
+		paths = append(paths, firestore.FieldPath{name})
+	}
+
+	return data, paths
+}
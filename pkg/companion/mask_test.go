@@ -0,0 +1,101 @@
+package companion
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSentInstanceFields(t *testing.T) {
+	tests := []struct {
+		name string
+		i    *v1.AppInstance
+		want []string
+	}{
+		{name: "empty", i: &v1.AppInstance{InstanceId: "instance1"}, want: nil},
+		{
+			name: "token and ref",
+			i:    &v1.AppInstance{InstanceId: "instance1", Token: "t1", Ref: "u1"},
+			want: []string{"ref", "token"},
+		},
+		{
+			name: "metadata",
+			i: &v1.AppInstance{
+				Platform:   v1.Platform_ANDROID,
+				AppVersion: "1.4.2",
+				OsVersion:  "11",
+				SdkVersion: "21.0.0",
+				Labels:     map[string]string{"beta": "true"},
+			},
+			want: []string{"app_version", "labels", "os_version", "platform", "sdk_version"},
+		},
+	}
+
+	for _, tt := range tests {
+		fields := sentInstanceFields(tt.i)
+		sort.Strings(fields)
+		if !reflect.DeepEqual(fields, tt.want) {
+			t.Errorf("%s: sentInstanceFields = %v, want %v", tt.name, fields, tt.want)
+		}
+	}
+}
+
+func TestMaskedInstance(t *testing.T) {
+	now := ptypes.TimestampNow()
+	i := &v1.AppInstance{InstanceId: "instance1", Token: "t1", UpdatedAt: now, LastSeenAt: now}
+
+	data, paths := maskedInstance(i, []string{"ref", "labels", "app_version"})
+
+	want := map[string]interface{}{
+		"instanceID": "instance1",
+		"updatedAt":  now,
+		"lastSeenAt": now,
+		// masked fields are written even if empty, so they're cleared
+		"ref":        "",
+		"labels":     map[string]string{},
+		"appVersion": "",
+	}
+	if !reflect.DeepEqual(data, want) {
+		t.Errorf("maskedInstance = %v, want %v", data, want)
+	}
+
+	if len(paths) != len(data) {
+		t.Fatalf("paths = %v, want a path of each written field", paths)
+	}
+	for _, p := range paths {
+		if _, ok := data[p[0]]; !ok || len(p) != 1 {
+			t.Errorf("path %v is not a written field", p)
+		}
+	}
+
+	i.CreatedAt, i.TokenUpdatedAt = now, now
+	data, _ = maskedInstance(i, nil)
+	if data["createdAt"] != now || data["tokenUpdatedAt"] != now || data["token"] != nil {
+		t.Errorf("maskedInstance = %v, want the set timestamps without unmasked fields", data)
+	}
+}
+
+func TestValidateInstanceMask(t *testing.T) {
+	tests := []struct {
+		name string
+		mask *field_mask.FieldMask
+		code codes.Code
+	}{
+		{name: "none", mask: nil},
+		{name: "writable", mask: &field_mask.FieldMask{Paths: []string{"token", "ref", "labels"}}},
+		{name: "empty", mask: &field_mask.FieldMask{}, code: codes.InvalidArgument},
+		{name: "server-side", mask: &field_mask.FieldMask{Paths: []string{"created_at"}}, code: codes.InvalidArgument},
+		{name: "unknown", mask: &field_mask.FieldMask{Paths: []string{"appVersion"}}, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		if err := validateInstanceMask(tt.mask); status.Code(err) != tt.code {
+			t.Errorf("%s: validateInstanceMask = %v, want %v", tt.name, err, tt.code)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package companion
+
+import (
+	"bytes"
+	"firebase.google.com/go/v4/messaging"
+	"github.com/golang/protobuf/proto"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const (
+	// maxBatchSize is the maximum number of messages or tokens FCM accepts
+	// in a single SendAll or SendMulticast call
+	maxBatchSize = 500
+)
+
+// template returns the message template with the given id
+func (s *Service) template(id string) (*v1.MessageTemplate, error) {
+	for _, t := range s.config.GetMessages() {
+		if t.Id == id {
+			return t, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "unknown template %q", id)
+}
+
+// buildMessage renders the template of the message and returns the FCM message
+// with merged data and the target of the message. Messages sent to a ref have no
+// target and must be expanded to the tokens of the ref, the same applies to the audience
+func (s *Service) buildMessage(m *v1.Message) (*messaging.Message, error) {
+	targets := 0
+	for _, t := range []string{m.Token, m.Topic, m.Condition, m.Ref, m.Audience} {
+		if t != "" {
+			targets++
+		}
+	}
+	if targets != 1 {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of token, topic, condition, ref, or audience must be specified")
+	}
+
+	if m.Audience != "" {
+		if _, err := parseAudience(m.Audience); err != nil {
+			return nil, err
+		}
+	}
+
+	msg, err := s.renderTemplate(m.TemplateId, m.TemplateData, m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	msg.Token = m.Token
+	msg.Topic = m.Topic
+	msg.Condition = m.Condition
+
+	return msg, nil
+}
+
+// buildMulticastMessage renders the template of the message and returns the FCM
+// multicast message with merged data
+func (s *Service) buildMulticastMessage(m *v1.MulticastMessage) (*messaging.MulticastMessage, error) {
+	targets := 0
+	for _, set := range []bool{len(m.Tokens) > 0, m.Audience != "", m.SegmentId != ""} {
+		if set {
+			targets++
+		}
+	}
+	if targets != 1 {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of tokens, audience, or segment_id must be specified")
+	}
+
+	if m.Audience != "" {
+		if _, err := parseAudience(m.Audience); err != nil {
+			return nil, err
+		}
+	}
+
+	msg, err := s.renderTemplate(m.TemplateId, m.TemplateData, m.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &messaging.MulticastMessage{
+		Tokens:       m.Tokens,
+		Data:         msg.Data,
+		Notification: msg.Notification,
+		Android:      msg.Android,
+		Webpush:      msg.Webpush,
+		APNS:         msg.APNS,
+	}, nil
+}
+
+// renderTemplate renders the template with the given id and merges the
+// data into the data of the template. Values in data take precedence
+func (s *Service) renderTemplate(templateID string, templateData, data map[string]string) (*messaging.Message, error) {
+	t, err := s.template(templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := render(t.FcmMessage, templateData)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := toFCMMessage(rendered)
+
+	group, err := renderString(t.CollapseGroup, templateData)
+	if err != nil {
+		return nil, err
+	}
+	applyCollapseGroup(msg, group)
+
+	if len(data) > 0 && msg.Data == nil {
+		msg.Data = map[string]string{}
+	}
+	for k, v := range data {
+		msg.Data[k] = v
+	}
+
+	return msg, nil
+}
+
+// render returns a copy of the message with all string values rendered as Go
+// templates using the provided data
+func render(m *v1.FCMMessage, data map[string]string) (*v1.FCMMessage, error) {
+	if m == nil {
+		return &v1.FCMMessage{}, nil
+	}
+
+	rendered := proto.Clone(m).(*v1.FCMMessage)
+	if err := renderReflect(proto.MessageReflect(rendered), data); err != nil {
+		return nil, err
+	}
+
+	return rendered, nil
+}
+
+// renderReflect renders all string fields, string lists and string map values
+// of the message and its sub-messages in place
+func renderReflect(m protoreflect.Message, data map[string]string) error {
+	// fields are collected first, so the message isn't mutated during the Range
+	var fields []protoreflect.FieldDescriptor
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		fields = append(fields, fd)
+		return true
+	})
+
+	for _, fd := range fields {
+		v := m.Get(fd)
+
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() != protoreflect.StringKind {
+				continue
+			}
+
+			mp := v.Map()
+			var keys []protoreflect.MapKey
+			mp.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+				keys = append(keys, k)
+				return true
+			})
+			for _, k := range keys {
+				r, err := renderString(mp.Get(k).String(), data)
+				if err != nil {
+					return err
+				}
+				mp.Set(k, protoreflect.ValueOfString(r))
+			}
+
+		case fd.IsList():
+			l := v.List()
+			for i := 0; i < l.Len(); i++ {
+				switch fd.Kind() {
+				case protoreflect.StringKind:
+					r, err := renderString(l.Get(i).String(), data)
+					if err != nil {
+						return err
+					}
+					l.Set(i, protoreflect.ValueOfString(r))
+				case protoreflect.MessageKind:
+					if err := renderReflect(l.Get(i).Message(), data); err != nil {
+						return err
+					}
+				}
+			}
+
+		case fd.Kind() == protoreflect.StringKind:
+			r, err := renderString(v.String(), data)
+			if err != nil {
+				return err
+			}
+			m.Set(fd, protoreflect.ValueOfString(r))
+
+		case fd.Kind() == protoreflect.MessageKind:
+			// well known types (e.g. Any or Timestamp) are never rendered
+			if fd.Message().FullName().Parent() == "google.protobuf" {
+				continue
+			}
+			if err := renderReflect(v.Message(), data); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// renderString executes the string as a Go template. Missing keys in data are
+// considered an error of the sender
+func renderString(s string, data map[string]string) (string, error) {
+	if !strings.Contains(s, "{{") {
+		return s, nil
+	}
+
+	t, err := template.New("").Option("missingkey=error").Parse(s)
+	if err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "invalid template %q: %v", s, err)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, data); err != nil {
+		return "", status.Errorf(codes.InvalidArgument, "cannot render template %q: %v", s, err)
+	}
+
+	return buf.String(), nil
+}
+
+// toFCMMessage converts the message configuration into the FCM message
+func toFCMMessage(m *v1.FCMMessage) *messaging.Message {
+	msg := &messaging.Message{
+		Data:      m.Data,
+		Token:     m.Token,
+		Topic:     m.Topic,
+		Condition: m.Condition,
+	}
+
+	if n := m.Notification; n != nil {
+		msg.Notification = &messaging.Notification{
+			Title:    n.Title,
+			Body:     n.Body,
+			ImageURL: n.ImageUrl,
+		}
+	}
+
+	if a := m.Android; a != nil {
+		msg.Android = &messaging.AndroidConfig{
+			CollapseKey:           a.CollapseKey,
+			Priority:              a.Priority,
+			RestrictedPackageName: a.RestrictedPackageName,
+			Data:                  a.Data,
+		}
+
+		// ttl is configured as a timestamp relative to the zero time
+		if a.Ttl != nil {
+			ttl := time.Duration(a.Ttl.Seconds)*time.Second + time.Duration(a.Ttl.Nanos)
+			msg.Android.TTL = &ttl
+		}
+
+		if n := a.Notification; n != nil {
+			msg.Android.Notification = &messaging.AndroidNotification{
+				Title:        n.Title,
+				Body:         n.Body,
+				Icon:         n.Icon,
+				Color:        n.Color,
+				Sound:        n.Sound,
+				Tag:          n.Tag,
+				ClickAction:  n.ClickAction,
+				BodyLocKey:   n.BodyLocKey,
+				BodyLocArgs:  n.BodyLocArgs,
+				TitleLocKey:  n.TitleLocKey,
+				TitleLocArgs: n.TitleLocArgs,
+				ChannelID:    n.ChannelId,
+				ImageURL:     n.ImageUrl,
+			}
+		}
+
+		if o := a.FcmOptions; o != nil {
+			msg.Android.FCMOptions = &messaging.AndroidFCMOptions{
+				AnalyticsLabel: o.AnalyticsLabel,
+			}
+		}
+	}
+
+	if w := m.Webpush; w != nil {
+		msg.Webpush = &messaging.WebpushConfig{
+			Headers: w.Headers,
+			Data:    w.Data,
+		}
+
+		// data and custom_data are Any values and are not converted
+		if n := w.Notification; n != nil {
+			wn := &messaging.WebpushNotification{
+				Title:              n.Title,
+				Body:               n.Body,
+				Icon:               n.Icon,
+				Badge:              n.Badge,
+				Direction:          n.Direction,
+				Image:              n.Image,
+				Language:           n.Language,
+				Renotify:           n.Renotify,
+				RequireInteraction: n.RequireInteraction,
+				Silent:             n.Silent,
+				Tag:                n.Tag,
+			}
+
+			if n.TimestampMillis != 0 {
+				ts := n.TimestampMillis
+				wn.TimestampMillis = &ts
+			}
+
+			for _, v := range n.Vibrate {
+				wn.Vibrate = append(wn.Vibrate, int(v))
+			}
+
+			for _, a := range n.Actions {
+				wn.Actions = append(wn.Actions, &messaging.WebpushNotificationAction{
+					Action: a.Action,
+					Title:  a.Title,
+					Icon:   a.Icon,
+				})
+			}
+
+			msg.Webpush.Notification = wn
+		}
+
+		if o := w.FcmOptions; o != nil {
+			msg.Webpush.FCMOptions = &messaging.WebpushFCMOptions{
+				Link: o.Link,
+			}
+		}
+	}
+
+	if o := m.FcmOptions; o != nil {
+		msg.FCMOptions = &messaging.FCMOptions{
+			AnalyticsLabel: o.AnalyticsLabel,
+		}
+	}
+
+	return msg
+}
@@ -0,0 +1,171 @@
+package companion
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"firebase.google.com/go/v4"
+	"fmt"
+	"google.golang.org/api/option"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"sync"
+	"testing"
+)
+
+// handlerTransport serves the requests of the client by the handler
+type handlerTransport http.HandlerFunc
+
+func (h handlerTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	rec := httptest.NewRecorder()
+	h(rec, r)
+	return rec.Result(), nil
+}
+
+// fcmMessage is the part of the sent FCM message checked by the tests
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Topic        string            `json:"topic"`
+	Data         map[string]string `json:"data"`
+	Notification *struct {
+		Title string `json:"title"`
+		Body  string `json:"body"`
+	} `json:"notification"`
+}
+
+// fakeFCM serves the single and the batch sends of the messaging client. Messages
+// are recorded when sent, or rejected by the error code set for their token
+type fakeFCM struct {
+	mu   sync.Mutex
+	sent []fcmMessage
+	// errors holds the FCM error codes returned for the tokens, e.g. UNREGISTERED
+	errors map[string]string
+}
+
+// newFakeFCM sets the messaging client of the service to send to the returned fake
+func newFakeFCM(t *testing.T, s *Service) *fakeFCM {
+	t.Helper()
+
+	f := &fakeFCM{errors: map[string]string{}}
+	ctx := context.Background()
+
+	hc := &http.Client{Transport: handlerTransport(f.ServeHTTP)}
+	app, err := firebase.NewApp(ctx, &firebase.Config{ProjectID: "test"}, option.WithHTTPClient(hc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.MessagingClient, err = app.Messaging(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	return f
+}
+
+// tokens returns the tokens of the sent messages
+func (f *fakeFCM) tokens() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var tokens []string
+	for _, m := range f.sent {
+		tokens = append(tokens, m.Token)
+	}
+	return tokens
+}
+
+func (f *fakeFCM) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/batch" {
+		code, body := f.answer(r.Body)
+		if code == http.StatusServiceUnavailable {
+			// the client doesn't retry responses with a long Retry-After
+			w.Header().Set("Retry-After", "3600")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_, _ = w.Write(body)
+		return
+	}
+
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	res := &bytes.Buffer{}
+	mw := multipart.NewWriter(res)
+	mr := multipart.NewReader(r.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		req, err := http.ReadRequest(bufio.NewReader(part))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		code, body := f.answer(req.Body)
+		pw, _ := mw.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/http"}})
+		_, _ = fmt.Fprintf(pw, "HTTP/1.1 %d %s\r\nContent-Type: application/json\r\n\r\n%s", code, http.StatusText(code), body)
+	}
+	_ = mw.Close()
+
+	w.Header().Set("Content-Type", "multipart/mixed; boundary="+mw.Boundary())
+	_, _ = w.Write(res.Bytes())
+}
+
+// answer records the sent message and returns the status code and the body of the response
+func (f *fakeFCM) answer(body io.Reader) (int, []byte) {
+	var req struct {
+		Message      fcmMessage `json:"message"`
+		ValidateOnly bool       `json:"validate_only"`
+	}
+	if err := json.NewDecoder(body).Decode(&req); err != nil {
+		return http.StatusBadRequest, fcmErrorBody("INVALID_ARGUMENT", "")
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if code, ok := f.errors[req.Message.Token]; ok {
+		switch code {
+		case "UNREGISTERED":
+			return http.StatusNotFound, fcmErrorBody("NOT_FOUND", code)
+		case "UNAVAILABLE":
+			return http.StatusServiceUnavailable, fcmErrorBody(code, code)
+		default:
+			return http.StatusBadRequest, fcmErrorBody("INVALID_ARGUMENT", code)
+		}
+	}
+
+	if !req.ValidateOnly {
+		f.sent = append(f.sent, req.Message)
+	}
+	return http.StatusOK, []byte(fmt.Sprintf(`{"name": "projects/test/messages/%d"}`, len(f.sent)))
+}
+
+// fcmErrorBody returns the body of the FCM error with the status and the FCM error code
+func fcmErrorBody(status, code string) []byte {
+	b, _ := json.Marshal(map[string]interface{}{
+		"error": map[string]interface{}{
+			"status":  status,
+			"message": "rejected by the fake",
+			"details": []map[string]string{{
+				"@type":     "type.googleapis.com/google.firebase.fcm.v1.FcmError",
+				"errorCode": code,
+			}},
+		},
+	})
+	return b
+}
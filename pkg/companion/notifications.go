@@ -0,0 +1,94 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	notificationsCollection = "fcm-companion-notifications"
+)
+
+// recordNotifications stores the notifications to the history. Failures are only
+// logged, as the notifications were already sent
+func (s *Service) recordNotifications(ctx context.Context, notifications []*v1.Notification) {
+	col := s.FirestoreClient.Collection(s.CollectionPrefix + notificationsCollection)
+
+	for start := 0; start < len(notifications); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(notifications) {
+			end = len(notifications)
+		}
+
+		batch := s.FirestoreClient.Batch()
+		for _, n := range notifications[start:end] {
+			batch.Create(col.NewDoc(), n)
+		}
+
+		if _, err := batch.Commit(ctx); err != nil {
+			s.Error("Cannot record notifications", zap.Int("notifications", end-start), zap.Error(err))
+		}
+	}
+}
+
+func (s *Service) ListNotifications(ctx context.Context, r *v1.ListNotificationsRequest) (*v1.NotificationList, error) {
+	if err := r.Validate(); err != nil {
+		return &v1.NotificationList{}, err
+	}
+
+	col := s.FirestoreClient.Collection(s.CollectionPrefix + notificationsCollection)
+	q := col.Query
+
+	f := r.GetFilter()
+	switch {
+	case f.GetInstanceId() != "":
+		q = q.Where("instanceIds", "array-contains", f.InstanceId)
+	case f.GetToken() != "":
+		q = q.Where("tokens", "array-contains", f.Token)
+	case f.GetRef() != "":
+		q = q.Where("ref", "==", f.Ref)
+	case len(f.GetLabels()) > 0:
+		return &v1.NotificationList{}, status.Error(codes.InvalidArgument, "notifications can't be filtered by labels")
+	}
+
+	if r.State != v1.Notification_STATE_UNSPECIFIED {
+		q = q.Where("state", "==", r.State)
+	}
+	q = q.OrderBy("createdAt", firestore.Desc)
+
+	// the page token is the id of the last notification of the previous page
+	if r.PageToken != "" {
+		last, err := col.Doc(r.PageToken).Get(ctx)
+		if err != nil {
+			return &v1.NotificationList{}, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		q = q.StartAfter(last)
+	}
+
+	pageSize := pageSize(r.PageSize)
+	docs, err := q.Limit(pageSize).Documents(ctx).GetAll()
+	if err != nil {
+		return &v1.NotificationList{}, err
+	}
+
+	list := &v1.NotificationList{}
+	for _, doc := range docs {
+		n := &v1.Notification{}
+		if err := doc.DataTo(n); err != nil {
+			return &v1.NotificationList{}, err
+		}
+		n.Id = doc.Ref.ID
+
+		list.Notifications = append(list.Notifications, n)
+	}
+
+	if len(docs) == pageSize {
+		list.NextPageToken = docs[len(docs)-1].Ref.ID
+	}
+
+	return list, nil
+}
@@ -0,0 +1,182 @@
+package companion
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"firebase.google.com/go/v4/messaging"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"time"
+)
+
+// sendPolicy applies the quiet hours and the frequency cap to the recipients of a message.
+// Tokens deferred by either of them are passed to the reschedule
+type sendPolicy struct {
+	templateID string
+	category   string
+	data       map[string]string
+	critical   bool
+
+	cap        *frequencyCap
+	counter    capCounter
+	reschedule func(ctx context.Context, tokens []string, at time.Time) error
+}
+
+// newSendPolicy returns the policy of the message. Deferred tokens are rescheduled as
+// multicast messages of the template, keyed by the idempotency key of the message, see
+// the deferralKey. Policies of a single request share the counter
+func (s *Service) newSendPolicy(idempotencyKey, templateID string, templateData, data map[string]string, critical bool, counter capCounter) *sendPolicy {
+	p := &sendPolicy{
+		templateID: templateID,
+		data:       data,
+		critical:   critical,
+		cap:        s.frequencyCapFor(templateID),
+		counter:    counter,
+	}
+	if t, err := s.template(templateID); err == nil {
+		p.category = t.Category
+	}
+
+	p.reschedule = func(ctx context.Context, tokens []string, at time.Time) error {
+		sendAt, err := ptypes.TimestampProto(at)
+		if err != nil {
+			return err
+		}
+
+		s.Debug("Tokens were deferred",
+			zap.String("templateID", templateID),
+			zap.Time("sendAt", at),
+			zap.Int("tokens", len(tokens)),
+		)
+
+		m := &v1.MulticastMessage{
+			TemplateId:   templateID,
+			TemplateData: templateData,
+			Data:         data,
+			Tokens:       tokens,
+			SendAt:       sendAt,
+			Critical:     critical,
+		}
+		if m.IdempotencyKey, err = deferralKey(ctx, idempotencyKey, m); err != nil {
+			return err
+		}
+
+		return s.scheduleMulticastMessage(ctx, m)
+	}
+
+	return p
+}
+
+// deferralKey returns the key of the deferred message, derived from the Pub/Sub delivery
+// of the request and the idempotency key of the message it was deferred from. Retried
+// and redelivered requests defer the same messages, which are then scheduled only once.
+// It returns an empty key if the request has neither of the keys
+func deferralKey(ctx context.Context, idempotencyKey string, deferred *v1.MulticastMessage) (string, error) {
+	var deliveryKey string
+	if env, ok := envelopeFromContext(ctx); ok {
+		deliveryKey = env.DeliveryKey()
+	}
+	if deliveryKey == "" && idempotencyKey == "" {
+		return "", nil
+	}
+
+	b := proto.NewBuffer(nil)
+	b.SetDeterministic(true)
+	if err := b.Marshal(deferred); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b.Bytes())
+
+	return "deferred:" + deliveryKey + ":" + idempotencyKey + ":" + hex.EncodeToString(sum[:]), nil
+}
+
+// notification returns the history record of the message sent to the tokens of the ref
+func (p *sendPolicy) notification(ref string, tokens []string, instances map[string]*v1.AppInstance, state v1.Notification_State, reason string) *v1.Notification {
+	n := &v1.Notification{
+		Data:       p.data,
+		Ref:        ref,
+		Tokens:     tokens,
+		TemplateId: p.templateID,
+		Category:   p.category,
+		State:      state,
+		Reason:     reason,
+		CreatedAt:  ptypes.TimestampNow(),
+	}
+
+	for _, t := range tokens {
+		if i, ok := instances[t]; ok {
+			n.InstanceIds = append(n.InstanceIds, i.InstanceId)
+		}
+	}
+
+	return n
+}
+
+// recordMessages records the sent messages. Messages sent to tokens are recorded
+// together for each ref, messages sent to topics and conditions one by one
+func (s *Service) recordMessages(ctx context.Context, p *sendPolicy, msgs []*messaging.Message, instances map[string]*v1.AppInstance) {
+	var tokens []string
+	var notifications []*v1.Notification
+	for _, msg := range msgs {
+		if msg.Token != "" {
+			tokens = append(tokens, msg.Token)
+			continue
+		}
+
+		n := p.notification("", nil, nil, v1.Notification_SENT, "")
+		n.Topic, n.Condition = msg.Topic, msg.Condition
+		notifications = append(notifications, n)
+	}
+
+	s.recordNotifications(ctx, append(notifications, p.sent(tokens, instances)...))
+}
+
+// sent returns the notifications of the message sent to the tokens, one for each ref
+func (p *sendPolicy) sent(tokens []string, instances map[string]*v1.AppInstance) []*v1.Notification {
+	var notifications []*v1.Notification
+	for _, r := range groupByRef(tokens, instances) {
+		notifications = append(notifications, p.notification(r.ref, r.tokens, instances, v1.Notification_SENT, ""))
+	}
+
+	return notifications
+}
+
+// failed returns the notification of the message rejected by FCM with the error
+func (p *sendPolicy) failed(msg *messaging.Message, instances map[string]*v1.AppInstance, err error) *v1.Notification {
+	if msg.Token != "" {
+		return p.notification(instances[msg.Token].GetRef(), []string{msg.Token}, instances, v1.Notification_FAILED, err.Error())
+	}
+
+	n := p.notification("", nil, nil, v1.Notification_FAILED, err.Error())
+	n.Topic, n.Condition = msg.Topic, msg.Condition
+	return n
+}
+
+// recipients are the tokens of a ref
+type recipients struct {
+	ref    string
+	tokens []string
+}
+
+// groupByRef groups the tokens by the refs of their instances in the order of the tokens.
+// Tokens without an instance or a ref are grouped under an empty ref
+func groupByRef(tokens []string, instances map[string]*v1.AppInstance) []*recipients {
+	var groups []*recipients
+	refs := map[string]*recipients{}
+	for _, t := range tokens {
+		ref := instances[t].GetRef()
+
+		r, ok := refs[ref]
+		if !ok {
+			r = &recipients{ref: ref}
+			refs[ref] = r
+			groups = append(groups, r)
+		}
+		r.tokens = append(r.tokens, t)
+	}
+
+	return groups
+}
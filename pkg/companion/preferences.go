@@ -0,0 +1,196 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	preferencesCollection = "fcm-companion-preferences"
+)
+
+// preferencesDoc returns the preferences document of the ref, or nil if the ref
+// can't be a document id
+func (s *Service) preferencesDoc(ref string) *firestore.DocumentRef {
+	if strings.Contains(ref, "/") || ref == "." || ref == ".." {
+		return nil
+	}
+
+	return s.FirestoreClient.Collection(s.CollectionPrefix + preferencesCollection).Doc(ref)
+}
+
+func (s *Service) GetPreferences(ctx context.Context, r *v1.GetPreferencesRequest) (*v1.Preferences, error) {
+	if err := r.Validate(); err != nil {
+		return &v1.Preferences{}, err
+	}
+
+	return s.preferences(ctx, r.Ref)
+}
+
+func (s *Service) UpdatePreferences(ctx context.Context, r *v1.Preferences) (*v1.Preferences, error) {
+	if err := r.Validate(); err != nil {
+		return &v1.Preferences{}, err
+	}
+
+	doc := s.preferencesDoc(r.Ref)
+	if doc == nil {
+		return &v1.Preferences{}, status.Errorf(codes.InvalidArgument, "invalid ref %q", r.Ref)
+	}
+
+	// categories and their channels are merged, empty maps would replace the stored ones
+	data := map[string]interface{}{
+		"ref":       r.Ref,
+		"updatedAt": ptypes.TimestampNow(),
+	}
+	categories := map[string]interface{}{}
+	for name, c := range r.Categories {
+		category := map[string]interface{}{"consent": c.GetConsent()}
+		if len(c.GetChannels()) > 0 {
+			channels := map[string]interface{}{}
+			for channel, consent := range c.Channels {
+				channels[channel] = consent
+			}
+			category["channels"] = channels
+		}
+		categories[name] = category
+	}
+	if len(categories) > 0 {
+		data["categories"] = categories
+	}
+
+	if _, err := doc.Set(ctx, data, firestore.MergeAll); err != nil {
+		return &v1.Preferences{}, err
+	}
+
+	return s.preferences(ctx, r.Ref)
+}
+
+// preferences returns the preferences of the ref. Refs without stored preferences
+// return empty preferences
+func (s *Service) preferences(ctx context.Context, ref string) (*v1.Preferences, error) {
+	doc := s.preferencesDoc(ref)
+	if doc == nil {
+		return &v1.Preferences{}, status.Errorf(codes.InvalidArgument, "invalid ref %q", ref)
+	}
+
+	snap, err := doc.Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return &v1.Preferences{Ref: ref}, nil
+	} else if err != nil {
+		return &v1.Preferences{}, err
+	}
+
+	p := &v1.Preferences{}
+	if err := snap.DataTo(p); err != nil {
+		return &v1.Preferences{}, err
+	}
+
+	return p, nil
+}
+
+// refPreferences returns the stored preferences of the refs
+func (s *Service) refPreferences(ctx context.Context, refs []string) (map[string]*v1.Preferences, error) {
+	var docs []*firestore.DocumentRef
+	for _, ref := range refs {
+		if doc := s.preferencesDoc(ref); doc != nil {
+			docs = append(docs, doc)
+		}
+	}
+
+	preferences := map[string]*v1.Preferences{}
+	if len(docs) == 0 {
+		return preferences, nil
+	}
+
+	snaps, err := s.FirestoreClient.GetAll(ctx, docs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, snap := range snaps {
+		if !snap.Exists() {
+			continue
+		}
+
+		p := &v1.Preferences{}
+		if err := snap.DataTo(p); err != nil {
+			return nil, err
+		}
+		preferences[snap.Ref.ID] = p
+	}
+
+	return preferences, nil
+}
+
+// consented returns true unless the preferences opted out of the category, either
+// for the platform channel or for the whole category
+func consented(p *v1.Preferences, category string, platform v1.Platform) bool {
+	c := p.GetCategories()[category]
+
+	channel := strings.ToLower(platform.String())
+	if consent := c.GetChannels()[channel]; consent != v1.Consent_CONSENT_UNSPECIFIED {
+		return consent != v1.Consent_OPT_OUT
+	}
+
+	return c.GetConsent() != v1.Consent_OPT_OUT
+}
+
+// optedOutTokens returns the tokens of the refs that opted out of the category of the
+// policy and records them as suppressed. Tokens without a ref always consent
+func (s *Service) optedOutTokens(ctx context.Context, p *sendPolicy, tokens []string, instances map[string]*v1.AppInstance) (map[string]bool, error) {
+	optedOut := map[string]bool{}
+	if p.category == "" {
+		return optedOut, nil
+	}
+
+	groups := groupByRef(tokens, instances)
+
+	var refs []string
+	for _, r := range groups {
+		if r.ref != "" {
+			refs = append(refs, r.ref)
+		}
+	}
+
+	preferences, err := s.refPreferences(ctx, refs)
+	if err != nil {
+		return nil, err
+	}
+
+	var suppressed []*v1.Notification
+	reason := fmt.Sprintf("opted out of %s", p.category)
+	for _, r := range groups {
+		prefs, ok := preferences[r.ref]
+		if !ok {
+			continue
+		}
+
+		var out []string
+		for _, t := range r.tokens {
+			if !consented(prefs, p.category, instances[t].GetPlatform()) {
+				out = append(out, t)
+				optedOut[t] = true
+			}
+		}
+		if len(out) == 0 {
+			continue
+		}
+
+		suppressed = append(suppressed, p.notification(r.ref, out, instances, v1.Notification_SUPPRESSED, reason))
+		s.Info("Notification was suppressed",
+			zap.String("templateID", p.templateID),
+			zap.String("ref", r.ref),
+			zap.String("reason", reason),
+		)
+	}
+
+	s.recordNotifications(ctx, suppressed)
+	return optedOut, nil
+}
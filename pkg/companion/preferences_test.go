@@ -0,0 +1,90 @@
+package companion
+
+import (
+	"context"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"reflect"
+	"testing"
+)
+
+func TestConsented(t *testing.T) {
+	p := &v1.Preferences{
+		Ref: "u1",
+		Categories: map[string]*v1.CategoryPreferences{
+			"news":   {Consent: v1.Consent_OPT_OUT, Channels: map[string]v1.Consent{"ios": v1.Consent_OPT_IN}},
+			"promo":  {Channels: map[string]v1.Consent{"android": v1.Consent_OPT_OUT}},
+			"alerts": {Consent: v1.Consent_OPT_IN},
+		},
+	}
+
+	tests := []struct {
+		category string
+		platform v1.Platform
+		want     bool
+	}{
+		{category: "news", platform: v1.Platform_ANDROID, want: false},
+		{category: "news", platform: v1.Platform_IOS, want: true},
+		{category: "news", platform: v1.Platform_PLATFORM_UNSPECIFIED, want: false},
+		{category: "promo", platform: v1.Platform_ANDROID, want: false},
+		{category: "promo", platform: v1.Platform_WEB, want: true},
+		{category: "alerts", platform: v1.Platform_ANDROID, want: true},
+		{category: "unknown", platform: v1.Platform_ANDROID, want: true},
+	}
+
+	for _, tt := range tests {
+		if got := consented(p, tt.category, tt.platform); got != tt.want {
+			t.Errorf("consented(%s, %s) = %v, want %v", tt.category, tt.platform, got, tt.want)
+		}
+	}
+
+	if !consented(nil, "news", v1.Platform_ANDROID) {
+		t.Error("consented without preferences = false, want true")
+	}
+}
+
+func TestOptedOutTokens(t *testing.T) {
+	s, f := newTestService(t)
+	ctx := context.Background()
+
+	for _, i := range []*v1.AppInstance{
+		{InstanceId: "instance1", Token: "t1", Ref: "u1", Platform: v1.Platform_ANDROID},
+		{InstanceId: "instance2", Token: "t2", Ref: "u1", Platform: v1.Platform_IOS},
+		{InstanceId: "instance3", Token: "t3", Ref: "u2", Platform: v1.Platform_ANDROID},
+		{InstanceId: "instance4", Token: "t4"},
+	} {
+		if _, err := s.PutInstance(ctx, i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := s.UpdatePreferences(ctx, &v1.Preferences{
+		Ref: "u1",
+		Categories: map[string]*v1.CategoryPreferences{
+			"news": {Consent: v1.Consent_OPT_OUT, Channels: map[string]v1.Consent{"ios": v1.Consent_OPT_IN}},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	tokens := []string{"t1", "t2", "t3", "t4"}
+	instances, err := s.tokenInstances(ctx, tokens)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	optedOut, err := s.optedOutTokens(ctx, &sendPolicy{templateID: "hello", category: "news"}, tokens, instances)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]bool{"t1": true}; !reflect.DeepEqual(optedOut, want) {
+		t.Errorf("optedOutTokens = %v, want %v", optedOut, want)
+	}
+	if n := f.count(notificationsCollection); n != 1 {
+		t.Errorf("%d notifications are recorded, want the suppressed one", n)
+	}
+
+	// messages without a category are always sent
+	optedOut, err = s.optedOutTokens(ctx, &sendPolicy{templateID: "hello"}, tokens, instances)
+	if err != nil || len(optedOut) != 0 {
+		t.Errorf("optedOutTokens without a category = %v, %v, want none", optedOut, err)
+	}
+}
@@ -0,0 +1,113 @@
+package companion
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/petomalina/xrpc/pkg/multiplexer"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io/ioutil"
+	"net/http"
+)
+
+// pushRoutes maps the gateway paths of the send RPCs to their methods
+var pushRoutes = map[string]string{
+	"/send":          MethodSend,
+	"/sendAll":       MethodSendAll,
+	"/sendMulticast": MethodSendMulticast,
+}
+
+// pushPath returns the gateway path of the method, or an empty string for unknown methods
+func pushPath(method string) string {
+	for path, m := range pushRoutes {
+		if m == method {
+			return path
+		}
+	}
+
+	return ""
+}
+
+// PushHandler wraps the handler of the requests pushed by Pub/Sub, see the
+// serverutil.WithPubSubMiddleware. Messages with the AttributeMethod are routed to
+// the path of the method, so a single push endpoint can serve all send RPCs.
+// Pushed messages of the send RPCs that can't be decoded or routed are stored as
+// dead letters and acknowledged, as the gateway would reject them and Pub/Sub
+// would push them again forever
+func (s *Service) PushHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method := pushRoutes[r.URL.Path]
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		push := &multiplexer.PushMessage{}
+		if err := json.Unmarshal(body, push); err != nil || push.Message == nil {
+			if method == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// the envelope can't be read, the whole body is kept
+			s.deadLetterPush(w, r, &envelope{Attributes: map[string]string{}}, method, body,
+				fmt.Errorf("cannot decode push envelope: %v", err))
+			return
+		}
+
+		env := &envelope{
+			MessageID:    push.Message.MessageID,
+			Subscription: push.Subscription,
+			Attributes:   push.Message.Attributes,
+		}
+		if env.Attributes == nil {
+			env.Attributes = map[string]string{}
+		}
+
+		if m := env.Attributes[AttributeMethod]; m != "" {
+			path := pushPath(m)
+			if path == "" {
+				s.deadLetterPush(w, r, env, m, push.Message.Data, status.Errorf(codes.Unimplemented, "unknown method %q", m))
+				return
+			}
+
+			method = m
+			r.URL.Path, r.URL.RawPath = path, ""
+		}
+
+		if method == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// the gateway accepts empty bodies
+		if len(push.Message.Data) > 0 {
+			if err := unmarshalBody(push.Message.Data, sendRequest(method)); err != nil {
+				s.deadLetterPush(w, r, env, method, push.Message.Data, err)
+				return
+			}
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// deadLetterPush stores the pushed payload as a dead letter and acknowledges the push,
+// or asks Pub/Sub to push it again if it can't be stored
+func (s *Service) deadLetterPush(w http.ResponseWriter, r *http.Request, env *envelope, method string, payload []byte, err error) {
+	if dlErr := s.deadLetterPayload(r.Context(), env, method, payload, err); dlErr != nil {
+		s.Error("Cannot store dead letter, message will be pushed again",
+			zap.String("method", method),
+			zap.Error(dlErr),
+		)
+		http.Error(w, dlErr.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
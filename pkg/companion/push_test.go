@@ -0,0 +1,113 @@
+package companion
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"github.com/petomalina/xrpc/pkg/multiplexer"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// pushBody returns the body pushed by Pub/Sub for the data
+func pushBody(data string, attributes map[string]string) string {
+	b, _ := json.Marshal(multiplexer.PushMessage{
+		Message: &multiplexer.PubSubMessage{
+			Data:       []byte(data),
+			Attributes: attributes,
+			MessageID:  "m1",
+		},
+		Subscription: "projects/p/subscriptions/push",
+	})
+	return string(b)
+}
+
+func TestPushHandler(t *testing.T) {
+	tests := []struct {
+		name, path, body string
+		passed           bool
+		// routed is the path the request is passed to, the path itself by default
+		routed string
+		// method and payload of the dead letter, the method of the path by default
+		method, payload string
+	}{
+		{name: "valid", path: "/send", body: pushBody(`{"message": {"token": "t1"}}`, nil), passed: true},
+		{name: "empty", path: "/send", body: pushBody("", nil), passed: true},
+		{name: "other path", path: "/cleanupInstances", body: "{", passed: true},
+		{name: "invalid data", path: "/sendAll", body: pushBody(`{"messages": 1}`, nil), payload: `{"messages": 1}`},
+		{name: "invalid envelope", path: "/send", body: "{", payload: "{"},
+		{name: "no message", path: "/send", body: "{}", payload: "{}"},
+		{
+			name:   "routed",
+			path:   "/push",
+			body:   pushBody(`{"messages": []}`, map[string]string{AttributeMethod: MethodSendAll}),
+			passed: true,
+			routed: "/sendAll",
+		},
+		{
+			name:   "rerouted",
+			path:   "/send",
+			body:   pushBody(`{"messages": []}`, map[string]string{AttributeMethod: MethodSendAll}),
+			passed: true,
+			routed: "/sendAll",
+		},
+		{
+			// the attribute is decoded as the request of its method
+			name:    "routed invalid data",
+			path:    "/sendAll",
+			body:    pushBody(`{"message": 1}`, map[string]string{AttributeMethod: MethodSend}),
+			method:  MethodSend,
+			payload: `{"message": 1}`,
+		},
+		{
+			name:    "unknown method",
+			path:    "/send",
+			body:    pushBody(`{}`, map[string]string{AttributeMethod: "Unknown"}),
+			method:  "Unknown",
+			payload: `{}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, _ := newTestService(t)
+
+			var passed, routed string
+			h := s.PushHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				passed, routed = string(body), r.URL.Path
+			}))
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, tt.path, strings.NewReader(tt.body)))
+
+			if tt.passed {
+				if tt.routed == "" {
+					tt.routed = tt.path
+				}
+				if passed != tt.body || routed != tt.routed {
+					t.Errorf("passed %q to %s, want %q to %s", passed, routed, tt.body, tt.routed)
+				}
+				return
+			}
+			if tt.method == "" {
+				tt.method = pushRoutes[tt.path]
+			}
+
+			if passed != "" || rec.Code != http.StatusNoContent {
+				t.Fatalf("push = %d, passed %q, want acknowledged", rec.Code, passed)
+			}
+
+			list, err := s.ListDeadLetters(context.Background(), &v1.ListDeadLettersRequest{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(list.DeadLetters) != 1 || list.DeadLetters[0].Payload != tt.payload || list.DeadLetters[0].Method != tt.method {
+				t.Errorf("dead letters = %v, want the %s payload %q", list.DeadLetters, tt.method, tt.payload)
+			}
+		})
+	}
+}
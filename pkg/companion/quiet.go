@@ -0,0 +1,227 @@
+package companion
+
+import (
+	"context"
+	"firebase.google.com/go/v4/messaging"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	// quietClockLayout is the layout of the start and end of the quiet hours
+	quietClockLayout = "15:04"
+)
+
+// quietHoursFor returns the quiet hours of the recipient of the template. The instance
+// is nil for recipients without a known instance, e.g. topics
+func (s *Service) quietHoursFor(templateID string, i *v1.AppInstance) *v1.QuietHours {
+	if i.GetQuietHours() != nil {
+		return i.QuietHours
+	}
+
+	if t, err := s.template(templateID); err == nil && t.Category != "" {
+		if q, ok := s.config.GetCategoryQuietHours()[t.Category]; ok {
+			return q
+		}
+	}
+
+	return s.config.GetQuietHours()
+}
+
+// quietUntil returns the end of the quiet hours if now is within them. The window is
+// evaluated in the timezone of the recipient, the timezone of the quiet hours or UTC
+func quietUntil(q *v1.QuietHours, timezone string, now time.Time) (time.Time, bool) {
+	if q == nil {
+		return time.Time{}, false
+	}
+
+	start, err := time.Parse(quietClockLayout, q.Start)
+	if err != nil {
+		return time.Time{}, false
+	}
+	end, err := time.Parse(quietClockLayout, q.End)
+	if err != nil || start.Equal(end) {
+		return time.Time{}, false
+	}
+
+	loc := time.UTC
+	for _, tz := range []string{timezone, q.Timezone} {
+		if l, err := time.LoadLocation(tz); tz != "" && err == nil {
+			loc = l
+			break
+		}
+	}
+
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	from, to := start.Hour()*60+start.Minute(), end.Hour()*60+end.Minute()
+
+	// windows spanning midnight end on the next day if they started today
+	day := local
+	if from < to {
+		if minute < from || minute >= to {
+			return time.Time{}, false
+		}
+	} else {
+		if minute < from && minute >= to {
+			return time.Time{}, false
+		}
+		if minute >= from {
+			day = local.AddDate(0, 0, 1)
+		}
+	}
+
+	return localInstant(day.Year(), day.Month(), day.Day(), end.Hour(), end.Minute(), loc), true
+}
+
+// tokenInstances returns the instances of the tokens. Tokens without an instance are omitted
+func (s *Service) tokenInstances(ctx context.Context, tokens []string) (map[string]*v1.AppInstance, error) {
+	instances := map[string]*v1.AppInstance{}
+	col := s.FirestoreClient.Collection(s.CollectionPrefix + instancesCollection)
+
+	for start := 0; start < len(tokens); start += maxInValues {
+		end := start + maxInValues
+		if end > len(tokens) {
+			end = len(tokens)
+		}
+
+		values := make([]interface{}, 0, end-start)
+		for _, t := range tokens[start:end] {
+			values = append(values, t)
+		}
+
+		docs, err := col.Where("token", "in", values).Documents(ctx).GetAll()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, doc := range docs {
+			i, err := instanceFromSnapshot(doc)
+			if err != nil {
+				return nil, err
+			}
+			instances[i.Token] = i
+		}
+	}
+
+	return instances, nil
+}
+
+// quietTokens partitions the tokens by their quiet hours to the tokens sent as usual,
+// the tokens sent silently and the tokens deferred to the end of their quiet hours
+func (s *Service) quietTokens(templateID string, tokens []string, instances map[string]*v1.AppInstance) (send, silent []string, deferred map[time.Time][]string) {
+	now := time.Now()
+	deferred = map[time.Time][]string{}
+	for _, t := range tokens {
+		i := instances[t]
+		q := s.quietHoursFor(templateID, i)
+
+		until, quiet := quietUntil(q, i.GetTimezone(), now)
+		switch {
+		case !quiet:
+			send = append(send, t)
+		case q.Action == v1.QuietHours_SILENT:
+			silent = append(silent, t)
+		default:
+			deferred[until] = append(deferred[until], t)
+		}
+	}
+
+	return send, silent, deferred
+}
+
+// quietMessage applies the quiet hours to the message sent to a single token, topic or
+// condition. Silenced messages are modified in place, deferred messages return the time
+// they must be sent at
+func (s *Service) quietMessage(m *v1.Message, msg *messaging.Message, instances map[string]*v1.AppInstance) time.Time {
+	if m.Critical {
+		return time.Time{}
+	}
+
+	i := instances[msg.Token]
+	q := s.quietHoursFor(m.TemplateId, i)
+
+	until, quiet := quietUntil(q, i.GetTimezone(), time.Now())
+	if !quiet {
+		return time.Time{}
+	}
+
+	if q.Action == v1.QuietHours_SILENT {
+		silence(&msg.Notification, &msg.Android, &msg.APNS, &msg.Webpush)
+		return time.Time{}
+	}
+
+	return until
+}
+
+// deferMessage schedules the message to the token at the given time. Messages with an
+// idempotency key are scheduled once per token, so redelivered messages aren't deferred again
+func (s *Service) deferMessage(ctx context.Context, m *v1.Message, token string, at time.Time) error {
+	sendAt, err := ptypes.TimestampProto(at)
+	if err != nil {
+		return err
+	}
+
+	m = proto.Clone(m).(*v1.Message)
+	if token != "" {
+		m.Token = token
+	}
+	m.Ref, m.Audience = "", ""
+	m.SendAt = sendAt
+	if m.IdempotencyKey != "" {
+		m.IdempotencyKey = "deferred:" + m.IdempotencyKey + ":" + m.Token
+	}
+
+	s.Debug("Message was deferred by quiet hours",
+		zap.String("templateID", m.TemplateId),
+		zap.Time("sendAt", at),
+	)
+
+	return s.scheduleMessage(ctx, m)
+}
+
+// silence turns the notification into a data message delivered without an alert.
+// The platform configurations are copied, as they may be shared with other messages
+func silence(n **messaging.Notification, android **messaging.AndroidConfig, apns **messaging.APNSConfig, webpush **messaging.WebpushConfig) {
+	*n = nil
+
+	if *android != nil {
+		a := **android
+		a.Notification = nil
+		*android = &a
+	}
+
+	if *webpush != nil {
+		w := **webpush
+		w.Notification = nil
+		*webpush = &w
+	}
+
+	// iOS requires background pushes to be content-available with a low priority
+	a := &messaging.APNSConfig{}
+	if *apns != nil {
+		c := **apns
+		a = &c
+	}
+
+	headers := map[string]string{}
+	for k, v := range a.Headers {
+		headers[k] = v
+	}
+	headers["apns-push-type"] = "background"
+	headers["apns-priority"] = "5"
+	a.Headers = headers
+
+	payload := &messaging.APNSPayload{}
+	if a.Payload != nil {
+		p := *a.Payload
+		payload = &p
+	}
+	payload.Aps = &messaging.Aps{ContentAvailable: true}
+	a.Payload = payload
+
+	*apns = a
+}
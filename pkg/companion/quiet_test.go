@@ -0,0 +1,104 @@
+package companion
+
+import (
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"testing"
+	"time"
+)
+
+func TestQuietUntil(t *testing.T) {
+	overnight := &v1.QuietHours{Start: "22:00", End: "07:00"}
+	lunch := &v1.QuietHours{Start: "12:00", End: "13:00", Timezone: "Europe/Prague"}
+
+	tests := []struct {
+		name     string
+		q        *v1.QuietHours
+		timezone string
+		now      time.Time
+		until    time.Time
+		quiet    bool
+	}{
+		{
+			name:     "before midnight ends tomorrow",
+			q:        overnight,
+			timezone: "Europe/Prague",
+			now:      time.Date(2020, time.June, 1, 21, 30, 0, 0, time.UTC),
+			until:    time.Date(2020, time.June, 2, 5, 0, 0, 0, time.UTC),
+			quiet:    true,
+		},
+		{
+			name:     "after midnight ends today",
+			q:        overnight,
+			timezone: "Europe/Prague",
+			now:      time.Date(2020, time.June, 2, 3, 0, 0, 0, time.UTC),
+			until:    time.Date(2020, time.June, 2, 5, 0, 0, 0, time.UTC),
+			quiet:    true,
+		},
+		{
+			name:     "start is inclusive",
+			q:        overnight,
+			timezone: "Europe/Prague",
+			now:      time.Date(2020, time.June, 1, 20, 0, 0, 0, time.UTC),
+			until:    time.Date(2020, time.June, 2, 5, 0, 0, 0, time.UTC),
+			quiet:    true,
+		},
+		{
+			name:     "end is exclusive",
+			q:        overnight,
+			timezone: "Europe/Prague",
+			now:      time.Date(2020, time.June, 2, 5, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "outside of the overnight window",
+			q:        overnight,
+			timezone: "Europe/Prague",
+			now:      time.Date(2020, time.June, 2, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "without a timezone in UTC",
+			q:     overnight,
+			now:   time.Date(2020, time.June, 1, 23, 0, 0, 0, time.UTC),
+			until: time.Date(2020, time.June, 2, 7, 0, 0, 0, time.UTC),
+			quiet: true,
+		},
+		{
+			name:  "in the timezone of the quiet hours",
+			q:     lunch,
+			now:   time.Date(2020, time.June, 1, 10, 30, 0, 0, time.UTC),
+			until: time.Date(2020, time.June, 1, 11, 0, 0, 0, time.UTC),
+			quiet: true,
+		},
+		{
+			name:     "invalid timezone of the recipient",
+			q:        lunch,
+			timezone: "Mars/Olympus_Mons",
+			now:      time.Date(2020, time.June, 1, 10, 30, 0, 0, time.UTC),
+			until:    time.Date(2020, time.June, 1, 11, 0, 0, 0, time.UTC),
+			quiet:    true,
+		},
+		{
+			name:     "outside of the window",
+			q:        lunch,
+			timezone: "UTC",
+			now:      time.Date(2020, time.June, 1, 11, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "empty window",
+			q:    &v1.QuietHours{Start: "10:00", End: "10:00"},
+			now:  time.Date(2020, time.June, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "without quiet hours",
+			now:  time.Date(2020, time.June, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			until, quiet := quietUntil(tt.q, tt.timezone, tt.now)
+			if quiet != tt.quiet || !until.Equal(tt.until) {
+				t.Errorf("quietUntil = %s, %v, want %s, %v", until.UTC(), quiet, tt.until, tt.quiet)
+			}
+		})
+	}
+}
@@ -0,0 +1,338 @@
+package companion
+
+import (
+	"context"
+	"firebase.google.com/go/v4/errorutils"
+	"firebase.google.com/go/v4/messaging"
+	"go.uber.org/zap"
+	"golang.org/x/time/rate"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RetryPolicy defines how messages rejected by FCM with transient errors or by the quota
+// are sent again. Only the failed messages or tokens of a batch are sent again
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts including the first one. 1 disables retries
+	MaxAttempts int
+	// BaseDelay is the delay after the first attempt, doubled after each next attempt
+	// up to the MaxDelay. The Retry-After of the quota errors takes precedence, but
+	// requests are never held longer than the MaxDelay. Messages whose Retry-After is
+	// longer fail with the quota error, so Pub/Sub redelivers them instead of the push
+	// request outliving its ack deadline
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// Jitter is the fraction of the delay that is randomized, between 0 and 1
+	Jitter float64
+}
+
+// DefaultRetryPolicy is used by services without a retry policy
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   time.Second,
+	MaxDelay:    time.Minute,
+	Jitter:      0.5,
+}
+
+// delay returns the delay after the attempt, starting at 1
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	d := p.BaseDelay
+	for i := 1; i < attempt && d < p.MaxDelay; i++ {
+		d *= 2
+	}
+	if d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+
+	return d - time.Duration(p.Jitter*rand.Float64()*float64(d))
+}
+
+// retryPolicy returns the retry policy of the service or the DefaultRetryPolicy
+func (s *Service) retryPolicy() RetryPolicy {
+	if s.RetryPolicy == nil {
+		return DefaultRetryPolicy
+	}
+
+	return *s.RetryPolicy
+}
+
+// NewSendLimiter returns a token bucket limiting the messages sent to FCM to the rate
+// of messages per second, allowing bursts of a second of messages
+func NewSendLimiter(perSecond float64) *rate.Limiter {
+	return rate.NewLimiter(rate.Limit(perSecond), int(math.Max(1, math.Ceil(perSecond))))
+}
+
+// quotaPause holds the sends of all requests when the FCM quota was exceeded
+type quotaPause struct {
+	sync.Mutex
+	until time.Time
+}
+
+// throttle waits until the n messages can be sent, i.e. the quota pause is over and the
+// limiter allows the messages. Messages above the burst of the limiter wait in chunks
+func (s *Service) throttle(ctx context.Context, n int) error {
+	s.quota.Lock()
+	until := s.quota.until
+	s.quota.Unlock()
+
+	if err := sleep(ctx, time.Until(until)); err != nil {
+		return err
+	}
+
+	if s.SendLimiter == nil {
+		return nil
+	}
+
+	for n > 0 {
+		chunk := n
+		if burst := s.SendLimiter.Burst(); chunk > burst {
+			chunk = burst
+		}
+
+		if err := s.SendLimiter.WaitN(ctx, chunk); err != nil {
+			return err
+		}
+		n -= chunk
+	}
+
+	return nil
+}
+
+// pause holds the sends of all requests for the delay, or the Retry-After of the quota error.
+// The pause is capped at the max delay, it returns false if the Retry-After is longer
+func (s *Service) pause(err error, delay, maxDelay time.Duration) (time.Duration, bool) {
+	capped := true
+	if d, ok := retryAfter(err); ok {
+		delay = d
+	}
+	if delay > maxDelay {
+		delay, capped = maxDelay, false
+	}
+
+	s.quota.Lock()
+	if until := time.Now().Add(delay); until.After(s.quota.until) {
+		s.quota.until = until
+	}
+	s.quota.Unlock()
+
+	return delay, capped
+}
+
+// sendBatch sends the n messages with the rate limit. Messages rejected by the FCM quota
+// or with transient errors are sent again by the retry policy, the send is called with
+// the indexes of the messages of each attempt. Responses are returned in the order of
+// the messages, with the outcome of their last attempt. Messages pending when the context
+// is done fail with its error
+func (s *Service) sendBatch(ctx context.Context, n int, send func(idx []int) (*messaging.BatchResponse, error)) (*messaging.BatchResponse, error) {
+	policy := s.retryPolicy()
+
+	pending := make([]int, n)
+	for i := range pending {
+		pending[i] = i
+	}
+
+	// once some messages were sent, later errors fail only the pending messages
+	sent := false
+	responses := make([]*messaging.SendResponse, n)
+	for attempt := 1; len(pending) > 0; attempt++ {
+		if err := s.throttle(ctx, len(pending)); err != nil {
+			if !sent {
+				return nil, err
+			}
+			failResponses(responses, pending, err)
+			break
+		}
+
+		res, err := send(pending)
+		retry := attempt < policy.MaxAttempts
+
+		var retryErr error
+		switch {
+		case err != nil && retry && isRetryableSend(err):
+			retryErr = err
+		case err != nil && !sent:
+			return nil, err
+		case err != nil:
+			failResponses(responses, pending, err)
+			pending = nil
+		default:
+			sent = true
+			var failed []int
+			for j, r := range res.Responses {
+				i := pending[j]
+				responses[i] = r
+				if !r.Success && retry && isRetryableSend(r.Error) {
+					failed = append(failed, i)
+					if retryErr == nil || isQuotaExceeded(r.Error) {
+						retryErr = r.Error
+					}
+				}
+			}
+			pending = failed
+		}
+
+		if retryErr == nil {
+			continue
+		}
+
+		// quota errors hold the sends of all requests, transient errors only this one
+		delay := policy.delay(attempt)
+		if isQuotaExceeded(retryErr) {
+			delay, capped := s.pause(retryErr, delay, policy.MaxDelay)
+			s.Warn("FCM quota exceeded, sending is paused",
+				zap.Int("messages", len(pending)),
+				zap.Int("attempt", attempt),
+				zap.Duration("delay", delay),
+			)
+			if !capped {
+				// the request is not held for the whole Retry-After, the pending messages
+				// fail with the quota error and are retried by the caller
+				if !sent {
+					return nil, err
+				}
+				failResponses(responses, pending, retryErr)
+				pending = nil
+			}
+			continue
+		}
+
+		s.Warn("FCM failed transiently, messages will be sent again",
+			zap.Int("messages", len(pending)),
+			zap.Int("attempt", attempt),
+			zap.Duration("delay", delay),
+			zap.Error(retryErr),
+		)
+		if sleepErr := sleep(ctx, delay); sleepErr != nil {
+			if !sent {
+				return nil, sleepErr
+			}
+			failResponses(responses, pending, sleepErr)
+			break
+		}
+	}
+
+	res := &messaging.BatchResponse{Responses: responses}
+	for _, r := range responses {
+		if r.Success {
+			res.SuccessCount++
+		} else {
+			res.FailureCount++
+		}
+	}
+
+	return res, nil
+}
+
+// failResponses fails the pending messages with the error. Messages of the previous
+// attempts were sent, so their responses are kept
+func failResponses(responses []*messaging.SendResponse, pending []int, err error) {
+	for _, i := range pending {
+		responses[i] = &messaging.SendResponse{Error: err}
+	}
+}
+
+// sendOne sends the message with the rate limit and the quota backoff of the sendBatch
+func (s *Service) sendOne(ctx context.Context, msg *messaging.Message) error {
+	res, err := s.sendBatch(ctx, 1, func([]int) (*messaging.BatchResponse, error) {
+		id, err := s.MessagingClient.Send(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		return &messaging.BatchResponse{
+			SuccessCount: 1,
+			Responses:    []*messaging.SendResponse{{Success: true, MessageID: id}},
+		}, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return res.Responses[0].Error
+}
+
+// sendMessages sends the messages with the rate limit and the quota backoff of the sendBatch
+func (s *Service) sendMessages(ctx context.Context, msgs []*messaging.Message, dryRun bool) (*messaging.BatchResponse, error) {
+	return s.sendBatch(ctx, len(msgs), func(idx []int) (*messaging.BatchResponse, error) {
+		batch := make([]*messaging.Message, len(idx))
+		for j, i := range idx {
+			batch[j] = msgs[i]
+		}
+
+		if dryRun {
+			return s.MessagingClient.SendAllDryRun(ctx, batch)
+		}
+		return s.MessagingClient.SendAll(ctx, batch)
+	})
+}
+
+// sendMulticastMessage sends the multicast message with the rate limit and the quota
+// backoff of the sendBatch
+func (s *Service) sendMulticastMessage(ctx context.Context, msg *messaging.MulticastMessage) (*messaging.BatchResponse, error) {
+	return s.sendBatch(ctx, len(msg.Tokens), func(idx []int) (*messaging.BatchResponse, error) {
+		m := *msg
+		m.Tokens = make([]string, len(idx))
+		for j, i := range idx {
+			m.Tokens[j] = msg.Tokens[i]
+		}
+
+		return s.MessagingClient.SendMulticast(ctx, &m)
+	})
+}
+
+// isQuotaExceeded returns true if the FCM rejected the message because of the quota
+func isQuotaExceeded(err error) bool {
+	return messaging.IsQuotaExceeded(err) ||
+		messaging.IsMessageRateExceeded(err) ||
+		errorutils.IsResourceExhausted(err)
+}
+
+// isRetryableSend returns true if the FCM rejected the message because of the quota
+// or a transient failure, so it may be accepted if sent again
+func isRetryableSend(err error) bool {
+	return isQuotaExceeded(err) ||
+		messaging.IsUnavailable(err) ||
+		messaging.IsServerUnavailable(err) ||
+		messaging.IsInternal(err) ||
+		errorutils.IsUnavailable(err) ||
+		errorutils.IsInternal(err)
+}
+
+// retryAfter returns the delay requested by the Retry-After header of the FCM response
+func retryAfter(err error) (time.Duration, bool) {
+	resp := errorutils.HTTPResponse(err)
+	if resp == nil {
+		return 0, false
+	}
+
+	header := resp.Header.Get("Retry-After")
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(header); err == nil {
+		return time.Until(t), true
+	}
+
+	return 0, false
+}
+
+// sleep waits for the duration or until the context is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,230 @@
+package companion
+
+import (
+	"context"
+	"firebase.google.com/go/v4"
+	"firebase.google.com/go/v4/messaging"
+	"fmt"
+	"go.uber.org/zap"
+	"google.golang.org/api/option"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// sendError returns the error of the FCM response with the status code and the Retry-After
+func sendError(t *testing.T, code int, status, retryAfter string) error {
+	t.Helper()
+
+	ctx := context.Background()
+	hc := &http.Client{Transport: handlerTransport(func(w http.ResponseWriter, r *http.Request) {
+		if retryAfter != "" {
+			w.Header().Set("Retry-After", retryAfter)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_, _ = fmt.Fprintf(w, `{"error": {"status": %q, "message": "failed"}}`, status)
+	})}
+
+	app, err := firebase.NewApp(ctx, &firebase.Config{ProjectID: "test"}, option.WithHTTPClient(hc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := app.Messaging(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Send(ctx, &messaging.Message{Token: "token"})
+	if err == nil {
+		t.Fatal("send succeeded, want an error")
+	}
+
+	return err
+}
+
+// unavailableError returns a transient FCM error. Its Retry-After is longer than the
+// client retries, so the error is returned right away
+func unavailableError(t *testing.T) error {
+	return sendError(t, http.StatusServiceUnavailable, "UNAVAILABLE", "3600")
+}
+
+func newRetryService(maxAttempts int) *Service {
+	return &Service{
+		Logger: zap.NewNop(),
+		RetryPolicy: &RetryPolicy{
+			MaxAttempts: maxAttempts,
+			BaseDelay:   time.Millisecond,
+			MaxDelay:    10 * time.Millisecond,
+		},
+	}
+}
+
+// batchSender returns the send of the sendBatch answering each attempt by the results,
+// and the indexes sent in the attempts
+func batchSender(results ...[]error) (func(idx []int) (*messaging.BatchResponse, error), *[][]int) {
+	var attempts [][]int
+
+	return func(idx []int) (*messaging.BatchResponse, error) {
+		errs := results[len(attempts)]
+		attempts = append(attempts, idx)
+
+		res := &messaging.BatchResponse{}
+		for _, err := range errs {
+			res.Responses = append(res.Responses, &messaging.SendResponse{Success: err == nil, Error: err})
+		}
+		return res, nil
+	}, &attempts
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	p := RetryPolicy{BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+	for attempt, want := range map[int]time.Duration{
+		1:  time.Second,
+		2:  2 * time.Second,
+		3:  4 * time.Second,
+		4:  8 * time.Second,
+		5:  10 * time.Second,
+		50: 10 * time.Second,
+	} {
+		if d := p.delay(attempt); d != want {
+			t.Errorf("delay(%d) = %s, want %s", attempt, d, want)
+		}
+	}
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if d := p.delay(3); d < 2*time.Second || d > 4*time.Second {
+			t.Fatalf("delay(3) with jitter = %s, want between 2s and 4s", d)
+		}
+	}
+}
+
+func TestSendBatchRetriesFailed(t *testing.T) {
+	s := newRetryService(3)
+	unavailable, invalid := unavailableError(t), sendError(t, http.StatusBadRequest, "INVALID_ARGUMENT", "")
+
+	send, attempts := batchSender(
+		[]error{nil, unavailable, invalid},
+		[]error{nil},
+	)
+	res, err := s.sendBatch(context.Background(), 3, send)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := [][]int{{0, 1, 2}, {1}}; !reflect.DeepEqual(*attempts, want) {
+		t.Errorf("attempts = %v, want %v", *attempts, want)
+	}
+	if res.SuccessCount != 2 || res.FailureCount != 1 || res.Responses[2].Error != invalid {
+		t.Errorf("response = %+v, want the invalid message failed", res)
+	}
+}
+
+func TestSendBatchMaxAttempts(t *testing.T) {
+	s := newRetryService(2)
+	unavailable := unavailableError(t)
+
+	send, attempts := batchSender([]error{unavailable}, []error{unavailable})
+	res, err := s.sendBatch(context.Background(), 1, send)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(*attempts) != 2 {
+		t.Errorf("attempts = %v, want 2", *attempts)
+	}
+	if res.FailureCount != 1 || res.Responses[0].Error != unavailable {
+		t.Errorf("response = %+v, want the message failed", res)
+	}
+}
+
+func TestSendBatchQuota(t *testing.T) {
+	s := newRetryService(3)
+	quota := sendError(t, http.StatusTooManyRequests, "RESOURCE_EXHAUSTED", "0")
+
+	send, attempts := batchSender([]error{quota, nil}, []error{nil})
+	res, err := s.sendBatch(context.Background(), 2, send)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := [][]int{{0, 1}, {0}}; !reflect.DeepEqual(*attempts, want) {
+		t.Errorf("attempts = %v, want %v", *attempts, want)
+	}
+	if res.SuccessCount != 2 {
+		t.Errorf("response = %+v, want all messages sent", res)
+	}
+}
+
+func TestSendBatchQuotaLongRetryAfter(t *testing.T) {
+	s := newRetryService(3)
+	quota := sendError(t, http.StatusTooManyRequests, "RESOURCE_EXHAUSTED", "3600")
+
+	send, attempts := batchSender([]error{quota, nil})
+	start := time.Now()
+	res, err := s.sendBatch(context.Background(), 2, send)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(*attempts) != 1 {
+		t.Errorf("attempts = %v, want 1", *attempts)
+	}
+	if res.FailureCount != 1 || res.Responses[0].Error != quota {
+		t.Errorf("response = %+v, want the message failed with the quota error", res)
+	}
+
+	// the next sends are paused for the max delay, not the Retry-After
+	s.quota.Lock()
+	until := s.quota.until
+	s.quota.Unlock()
+	if until.Sub(start) > time.Second {
+		t.Errorf("sending is paused until %s, want at most the max delay", until)
+	}
+}
+
+func TestSendBatchError(t *testing.T) {
+	s := newRetryService(3)
+	invalid := sendError(t, http.StatusBadRequest, "INVALID_ARGUMENT", "")
+
+	attempts := 0
+	_, err := s.sendBatch(context.Background(), 2, func([]int) (*messaging.BatchResponse, error) {
+		attempts++
+		return nil, invalid
+	})
+
+	if err != invalid || attempts != 1 {
+		t.Errorf("sendBatch = %v after %d attempts, want the invalid error after 1", err, attempts)
+	}
+}
+
+func TestSendBatchContextDone(t *testing.T) {
+	s := newRetryService(3)
+	s.RetryPolicy.BaseDelay, s.RetryPolicy.MaxDelay = time.Hour, time.Hour
+	unavailable := unavailableError(t)
+
+	// the context is done while waiting for the retry of the first attempt
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	send, attempts := batchSender([]error{nil, unavailable})
+	res, err := s.sendBatch(ctx, 2, send)
+	if err != nil {
+		t.Fatalf("sendBatch = %v, want the responses of the sent messages", err)
+	}
+
+	if len(*attempts) != 1 {
+		t.Errorf("attempts = %v, want 1", *attempts)
+	}
+	if !res.Responses[0].Success || res.Responses[1].Error != context.DeadlineExceeded || res.SuccessCount != 1 {
+		t.Errorf("response = %+v, want the pending message failed with the context error", res)
+	}
+
+	// nothing was sent by the failed batch, so the error is returned
+	failed := func([]int) (*messaging.BatchResponse, error) { return nil, unavailable }
+	if _, err := s.sendBatch(ctx, 1, failed); err != context.DeadlineExceeded {
+		t.Errorf("sendBatch with a done context = %v, want the context error", err)
+	}
+}
@@ -0,0 +1,456 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"time"
+)
+
+const (
+	scheduledCollection = "fcm-companion-scheduled"
+
+	// DefaultSchedulerInterval is how often the scheduler looks for due notifications
+	DefaultSchedulerInterval = 10 * time.Second
+
+	// schedulerBatchSize is the maximum number of notifications processed in a single run
+	schedulerBatchSize = 100
+	// schedulerLease is how long a replica owns the notification it's sending before
+	// other replicas consider it abandoned. The lease is renewed while sending
+	schedulerLease = 2 * time.Minute
+	// schedulerRetryDelay is the delay of a retry of a notification that failed
+	// with a retryable error
+	schedulerRetryDelay = time.Minute
+	// schedulerMaxAttempts is the number of attempts after which a notification that
+	// keeps failing with retryable errors, or being abandoned, fails
+	schedulerMaxAttempts = 5
+)
+
+// isScheduled returns true if the send_at is in the future
+func isScheduled(sendAt *timestamp.Timestamp) bool {
+	if sendAt == nil {
+		return false
+	}
+
+	t, err := ptypes.Timestamp(sendAt)
+	return err == nil && t.After(time.Now())
+}
+
+// schedule stores the request to be dispatched to the method at the sendAt time.
+// Requests scheduled with the same id are stored only once
+func (s *Service) schedule(ctx context.Context, id, method string, r proto.Message, sendAt *timestamp.Timestamp) error {
+	n, err := scheduledNotification(method, r, sendAt)
+	if err != nil {
+		return err
+	}
+
+	col := s.FirestoreClient.Collection(s.CollectionPrefix + scheduledCollection)
+	doc := col.NewDoc()
+	if id != "" {
+		doc = col.Doc(scheduledID(id))
+	}
+
+	_, err = doc.Create(ctx, n)
+	if status.Code(err) == codes.AlreadyExists {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	at, _ := ptypes.Timestamp(sendAt)
+	s.Info("Notification was scheduled",
+		zap.String("id", doc.ID),
+		zap.String("method", method),
+		zap.Time("sendAt", at),
+	)
+
+	return nil
+}
+
+// scheduledID returns the id of the notification scheduled with the key. Keys are hashed,
+// as they may contain characters that are not allowed in document ids
+func scheduledID(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// scheduledNotification returns the pending notification of the request
+func scheduledNotification(method string, r proto.Message, sendAt *timestamp.Timestamp) (*v1.ScheduledNotification, error) {
+	payload, err := (&jsonpb.Marshaler{}).MarshalToString(r)
+	if err != nil {
+		return nil, err
+	}
+
+	refs, tokens := requestRecipients(r)
+
+	return &v1.ScheduledNotification{
+		Method:    method,
+		Payload:   payload,
+		SendAt:    sendAt,
+		State:     v1.ScheduledNotification_PENDING,
+		CreatedAt: ptypes.TimestampNow(),
+		DueAt:     sendAt,
+		Refs:      refs,
+		Tokens:    tokens,
+	}, nil
+}
+
+// scheduleMessage schedules the message as a Send request. The scheduled request is
+// sent without the send_at and idempotency_key, which identifies the scheduled notification
+func (s *Service) scheduleMessage(ctx context.Context, m *v1.Message) error {
+	m = proto.Clone(m).(*v1.Message)
+	id, sendAt := m.IdempotencyKey, m.SendAt
+	m.IdempotencyKey, m.SendAt = "", nil
+
+	return s.schedule(ctx, id, MethodSend, &v1.SendRequest{Message: m}, sendAt)
+}
+
+// scheduleMulticastMessage schedules the message as a SendMulticast request, see scheduleMessage
+func (s *Service) scheduleMulticastMessage(ctx context.Context, m *v1.MulticastMessage) error {
+	m = proto.Clone(m).(*v1.MulticastMessage)
+	id, sendAt := m.IdempotencyKey, m.SendAt
+	m.IdempotencyKey, m.SendAt = "", nil
+
+	return s.schedule(ctx, id, MethodSendMulticast, &v1.SendMulticastRequest{Message: m}, sendAt)
+}
+
+// RunScheduler sends the due scheduled notifications and digests every interval until
+// the context is canceled. The scheduler can run on multiple replicas, as notifications
+// are leased by the replica sending them
+func (s *Service) RunScheduler(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DefaultSchedulerInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		// due digests are scheduled to be sent by the same run
+		if err := s.flushDigests(ctx); err != nil && ctx.Err() == nil {
+			s.Error("Cannot flush digests", zap.Error(err))
+		}
+		if err := s.runScheduled(ctx); err != nil && ctx.Err() == nil {
+			s.Error("Cannot run scheduled notifications", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runScheduled sends the notifications that are due, including the ones abandoned
+// by other replicas
+func (s *Service) runScheduled(ctx context.Context) error {
+	docs, err := s.FirestoreClient.Collection(s.CollectionPrefix+scheduledCollection).
+		Where("state", "in", []interface{}{v1.ScheduledNotification_PENDING, v1.ScheduledNotification_SENDING}).
+		Where("dueAt", "<=", time.Now()).
+		OrderBy("dueAt", firestore.Asc).
+		Limit(schedulerBatchSize).
+		Documents(ctx).
+		GetAll()
+	if err != nil {
+		return err
+	}
+
+	for _, doc := range docs {
+		n, lease, err := s.leaseScheduled(ctx, doc.Ref)
+		if err != nil {
+			s.Warn("Cannot lease scheduled notification", zap.String("id", doc.Ref.ID), zap.Error(err))
+			continue
+		}
+		if n == nil {
+			continue
+		}
+
+		err = s.dispatchLeased(ctx, doc.Ref, lease, n)
+		if err := s.finishScheduled(ctx, doc.Ref, lease, err); err != nil {
+			s.Error("Cannot finish scheduled notification", zap.String("id", doc.Ref.ID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// dispatchLeased sends the leased notification and renews the lease until the send
+// returns. The send is canceled if the lease is lost, so it's not sent by two replicas
+func (s *Service) dispatchLeased(ctx context.Context, doc *firestore.DocumentRef, lease string, n *v1.ScheduledNotification) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		ticker := time.NewTicker(schedulerLease / 3)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			ok, err := s.renewLease(ctx, doc, lease)
+			if err != nil {
+				s.Warn("Cannot renew the lease of scheduled notification", zap.String("id", doc.ID), zap.Error(err))
+				continue
+			}
+			if !ok {
+				s.Warn("Lease of scheduled notification was lost", zap.String("id", doc.ID))
+				cancel()
+				return
+			}
+		}
+	}()
+
+	// digests are sent as messages that are not digested again
+	method := n.Method
+	if method == methodSendDigest {
+		ctx, method = context.WithValue(ctx, digestedKey{}, true), MethodSend
+	}
+
+	return s.dispatch(ctx, method, []byte(n.Payload))
+}
+
+// leaseScheduled marks the notification as being sent by this replica and returns the
+// lease identifying it. It returns nil if the notification is not due anymore, e.g. it
+// was leased by another replica. Notifications abandoned after the schedulerMaxAttempts
+// fail instead
+func (s *Service) leaseScheduled(ctx context.Context, doc *firestore.DocumentRef) (*v1.ScheduledNotification, string, error) {
+	var leased *v1.ScheduledNotification
+	lease, err := newLease()
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = s.FirestoreClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		leased = nil
+
+		snap, err := tx.Get(doc)
+		if err != nil {
+			return err
+		}
+
+		n := &v1.ScheduledNotification{}
+		if err := snap.DataTo(n); err != nil {
+			return err
+		}
+
+		if n.State != v1.ScheduledNotification_PENDING && n.State != v1.ScheduledNotification_SENDING {
+			return nil
+		}
+		if dueAt, err := ptypes.Timestamp(n.DueAt); err != nil || dueAt.After(time.Now()) {
+			return nil
+		}
+
+		if n.Attempts >= schedulerMaxAttempts {
+			s.Warn("Scheduled notification failed", zap.String("id", doc.ID), zap.Int32("attempts", n.Attempts))
+			return tx.Update(doc, []firestore.Update{
+				{Path: "state", Value: v1.ScheduledNotification_FAILED},
+				{Path: "dueAt", Value: firestore.Delete},
+				{Path: "lease", Value: firestore.Delete},
+				{Path: "lastError", Value: fmt.Sprintf("abandoned after %d attempts", n.Attempts)},
+			})
+		}
+
+		leased = n
+		return tx.Update(doc, []firestore.Update{
+			{Path: "state", Value: v1.ScheduledNotification_SENDING},
+			{Path: "dueAt", Value: time.Now().Add(schedulerLease)},
+			{Path: "lease", Value: lease},
+			{Path: "attempts", Value: firestore.Increment(1)},
+		})
+	})
+
+	return leased, lease, err
+}
+
+// renewLease extends the lease of the notification. It returns false if the lease
+// is not held by this replica anymore
+func (s *Service) renewLease(ctx context.Context, doc *firestore.DocumentRef, lease string) (bool, error) {
+	held := false
+
+	err := s.FirestoreClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		held = false
+
+		snap, err := tx.Get(doc)
+		if err != nil {
+			return err
+		}
+		if !holdsLease(snap, lease) {
+			return nil
+		}
+
+		held = true
+		return tx.Update(doc, []firestore.Update{
+			{Path: "dueAt", Value: time.Now().Add(schedulerLease)},
+		})
+	})
+
+	return held, err
+}
+
+// finishScheduled stores the result of the send, unless the lease was lost to another
+// replica. Retryable errors are retried after the schedulerRetryDelay until the
+// schedulerMaxAttempts
+func (s *Service) finishScheduled(ctx context.Context, doc *firestore.DocumentRef, lease string, sendErr error) error {
+	return s.FirestoreClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		snap, err := tx.Get(doc)
+		if err != nil {
+			return err
+		}
+		if !holdsLease(snap, lease) {
+			s.Warn("Lease of scheduled notification was lost", zap.String("id", doc.ID), zap.Error(sendErr))
+			return nil
+		}
+
+		n := &v1.ScheduledNotification{}
+		if err := snap.DataTo(n); err != nil {
+			return err
+		}
+
+		updates := []firestore.Update{
+			{Path: "state", Value: v1.ScheduledNotification_SENT},
+			{Path: "dueAt", Value: firestore.Delete},
+			{Path: "lease", Value: firestore.Delete},
+		}
+
+		switch {
+		case sendErr == nil:
+			s.Info("Scheduled notification was sent", zap.String("id", doc.ID))
+		case isRetryable(sendErr) && n.Attempts < schedulerMaxAttempts:
+			updates = []firestore.Update{
+				{Path: "state", Value: v1.ScheduledNotification_PENDING},
+				{Path: "dueAt", Value: time.Now().Add(schedulerRetryDelay)},
+				{Path: "lease", Value: firestore.Delete},
+				{Path: "lastError", Value: sendErr.Error()},
+			}
+			s.Warn("Scheduled notification will be retried", zap.String("id", doc.ID), zap.Error(sendErr))
+		default:
+			updates = []firestore.Update{
+				{Path: "state", Value: v1.ScheduledNotification_FAILED},
+				{Path: "dueAt", Value: firestore.Delete},
+				{Path: "lease", Value: firestore.Delete},
+				{Path: "lastError", Value: sendErr.Error()},
+			}
+			s.Warn("Scheduled notification failed", zap.String("id", doc.ID), zap.Error(sendErr))
+		}
+
+		return tx.Update(doc, updates)
+	})
+}
+
+// holdsLease returns true if the notification is being sent with the lease
+func holdsLease(snap *firestore.DocumentSnapshot, lease string) bool {
+	held, err := snap.DataAt("lease")
+	return err == nil && held == lease
+}
+
+// newLease returns a random lease of a scheduled notification
+func newLease() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+func (s *Service) ListScheduled(ctx context.Context, r *v1.ListScheduledRequest) (*v1.ScheduledNotificationList, error) {
+	if err := r.Validate(); err != nil {
+		return &v1.ScheduledNotificationList{}, err
+	}
+
+	col := s.FirestoreClient.Collection(s.CollectionPrefix + scheduledCollection)
+	q := col.OrderBy("sendAt", firestore.Asc)
+	if r.State != v1.ScheduledNotification_STATE_UNSPECIFIED {
+		q = col.Where("state", "==", r.State).OrderBy("sendAt", firestore.Asc)
+	}
+
+	// the page token is the id of the last notification of the previous page
+	if r.PageToken != "" {
+		last, err := col.Doc(r.PageToken).Get(ctx)
+		if err != nil {
+			return &v1.ScheduledNotificationList{}, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		q = q.StartAfter(last)
+	}
+
+	pageSize := pageSize(r.PageSize)
+	docs, err := q.Limit(pageSize).Documents(ctx).GetAll()
+	if err != nil {
+		return &v1.ScheduledNotificationList{}, err
+	}
+
+	list := &v1.ScheduledNotificationList{}
+	for _, doc := range docs {
+		n := &v1.ScheduledNotification{}
+		if err := doc.DataTo(n); err != nil {
+			return &v1.ScheduledNotificationList{}, err
+		}
+		n.Id = doc.Ref.ID
+
+		list.Notifications = append(list.Notifications, n)
+	}
+
+	if len(docs) == pageSize {
+		list.NextPageToken = docs[len(docs)-1].Ref.ID
+	}
+
+	return list, nil
+}
+
+func (s *Service) CancelScheduled(ctx context.Context, r *v1.CancelScheduledRequest) (*empty.Empty, error) {
+	if err := r.Validate(); err != nil {
+		return &empty.Empty{}, err
+	}
+
+	if strings.Contains(r.Id, "/") {
+		return &empty.Empty{}, status.Errorf(codes.InvalidArgument, "invalid id %q", r.Id)
+	}
+
+	doc := s.FirestoreClient.Collection(s.CollectionPrefix + scheduledCollection).Doc(r.Id)
+
+	err := s.FirestoreClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		snap, err := tx.Get(doc)
+		if status.Code(err) == codes.NotFound {
+			return status.Errorf(codes.NotFound, "scheduled notification %q not found", r.Id)
+		} else if err != nil {
+			return err
+		}
+
+		n := &v1.ScheduledNotification{}
+		if err := snap.DataTo(n); err != nil {
+			return err
+		}
+
+		if n.State != v1.ScheduledNotification_PENDING {
+			return status.Errorf(codes.FailedPrecondition, "scheduled notification %q is %s", r.Id, n.State)
+		}
+
+		return tx.Update(doc, []firestore.Update{
+			{Path: "state", Value: v1.ScheduledNotification_CANCELED},
+			{Path: "dueAt", Value: firestore.Delete},
+		})
+	})
+
+	return &empty.Empty{}, err
+}
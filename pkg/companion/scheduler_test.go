@@ -0,0 +1,203 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// scheduledAt returns the stored scheduled notification
+func scheduledAt(t *testing.T, s *Service, doc *firestore.DocumentRef) *v1.ScheduledNotification {
+	t.Helper()
+
+	snap, err := doc.Get(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := &v1.ScheduledNotification{}
+	if err := snap.DataTo(n); err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+// scheduleDue stores a Send of the message to the token that is due now
+func scheduleDue(t *testing.T, s *Service, id, token string) *firestore.DocumentRef {
+	t.Helper()
+
+	sendAt, _ := ptypes.TimestampProto(time.Now().Add(-time.Second))
+	r := &v1.SendRequest{Message: &v1.Message{TemplateId: "hello", Token: token}}
+	if err := s.schedule(context.Background(), id, MethodSend, r, sendAt); err != nil {
+		t.Fatal(err)
+	}
+
+	return s.FirestoreClient.Collection(s.CollectionPrefix + scheduledCollection).Doc(scheduledID(id))
+}
+
+func TestScheduleMessage(t *testing.T) {
+	s, f := newTestService(t)
+	ctx := context.Background()
+
+	sendAt, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	r := &v1.SendRequest{Message: &v1.Message{TemplateId: "hello", Token: "t1", SendAt: sendAt, IdempotencyKey: "k1"}}
+
+	// the request is scheduled once by its idempotency key
+	for n := 0; n < 2; n++ {
+		if err := s.scheduleMessage(ctx, r.Message); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := f.count(scheduledCollection); n != 1 {
+		t.Fatalf("%d notifications are scheduled, want 1", n)
+	}
+
+	list, err := s.ListScheduled(ctx, &v1.ListScheduledRequest{State: v1.ScheduledNotification_PENDING})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Notifications) != 1 {
+		t.Fatalf("scheduled = %v, want the pending notification", list.Notifications)
+	}
+	n := list.Notifications[0]
+	if n.Method != MethodSend || !reflect.DeepEqual(n.Tokens, []string{"t1"}) || n.Payload == "" {
+		t.Errorf("scheduled = %v, want the Send to t1", n)
+	}
+
+	// the scheduled request is sent without the schedule and the key
+	scheduled := &v1.SendRequest{}
+	if err := unmarshalBody([]byte(n.Payload), scheduled); err != nil {
+		t.Fatal(err)
+	}
+	if scheduled.Message.SendAt != nil || scheduled.Message.IdempotencyKey != "" {
+		t.Errorf("scheduled request = %v, want it sent right away", scheduled)
+	}
+
+	if _, err := s.CancelScheduled(ctx, &v1.CancelScheduledRequest{Id: n.Id}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.CancelScheduled(ctx, &v1.CancelScheduledRequest{Id: n.Id}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("second CancelScheduled = %v, want FailedPrecondition", err)
+	}
+	if _, err := s.CancelScheduled(ctx, &v1.CancelScheduledRequest{Id: "unknown"}); status.Code(err) != codes.NotFound {
+		t.Errorf("CancelScheduled of an unknown id = %v, want NotFound", err)
+	}
+	if _, err := s.CancelScheduled(ctx, &v1.CancelScheduledRequest{Id: "a/b"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CancelScheduled of a path = %v, want InvalidArgument", err)
+	}
+}
+
+func TestRunScheduled(t *testing.T) {
+	s, _ := newTestService(t)
+	fcm := newFakeFCM(t, s)
+	ctx := context.Background()
+
+	sent := scheduleDue(t, s, "sent", "t1")
+	rejected := scheduleDue(t, s, "rejected", "t2")
+	fcm.errors["t2"] = "INVALID_ARGUMENT"
+
+	sendAt, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	if err := s.schedule(ctx, "later", MethodSend, &v1.SendRequest{Message: &v1.Message{TemplateId: "hello", Token: "t3"}}, sendAt); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.runScheduled(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if tokens := fcm.tokens(); !reflect.DeepEqual(tokens, []string{"t1"}) {
+		t.Errorf("sent to %v, want only the due notification", tokens)
+	}
+	if n := scheduledAt(t, s, sent); n.State != v1.ScheduledNotification_SENT || n.Attempts != 1 || n.DueAt != nil {
+		t.Errorf("sent notification = %v, want SENT after an attempt", n)
+	}
+	if n := scheduledAt(t, s, rejected); n.State != v1.ScheduledNotification_FAILED || n.LastError == "" {
+		t.Errorf("rejected notification = %v, want FAILED with the error", n)
+	}
+}
+
+func TestLeaseScheduled(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	doc := scheduleDue(t, s, "n1", "t1")
+
+	n, lease, err := s.leaseScheduled(ctx, doc)
+	if err != nil || n == nil {
+		t.Fatalf("leaseScheduled = %v, %v, want leased", n, err)
+	}
+
+	// the leased notification is due only after the lease expires
+	if other, _, err := s.leaseScheduled(ctx, doc); err != nil || other != nil {
+		t.Errorf("second leaseScheduled = %v, %v, want nothing leased", other, err)
+	}
+
+	if held, err := s.renewLease(ctx, doc, lease); err != nil || !held {
+		t.Errorf("renewLease = %v, %v, want held", held, err)
+	}
+	if held, err := s.renewLease(ctx, doc, "other"); err != nil || held {
+		t.Errorf("renewLease of another lease = %v, %v, want not held", held, err)
+	}
+
+	// results of a lost lease are not stored
+	if err := s.finishScheduled(ctx, doc, "other", nil); err != nil {
+		t.Fatal(err)
+	}
+	if stored := scheduledAt(t, s, doc); stored.State != v1.ScheduledNotification_SENDING {
+		t.Errorf("notification = %v, want still SENDING", stored)
+	}
+
+	// retryable errors are retried later
+	if err := s.finishScheduled(ctx, doc, lease, status.Error(codes.Unavailable, "unavailable")); err != nil {
+		t.Fatal(err)
+	}
+	stored := scheduledAt(t, s, doc)
+	if dueAt, _ := ptypes.Timestamp(stored.DueAt); stored.State != v1.ScheduledNotification_PENDING || !dueAt.After(time.Now()) {
+		t.Errorf("notification = %v, want PENDING with a later due time", stored)
+	}
+}
+
+func TestLeaseScheduledAttempts(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	// the notification is abandoned by the replicas sending it
+	doc := scheduleDue(t, s, "n1", "t1")
+	for attempt := 1; attempt <= schedulerMaxAttempts; attempt++ {
+		if n, _, err := s.leaseScheduled(ctx, doc); err != nil || n == nil {
+			t.Fatalf("attempt %d: leaseScheduled = %v, %v, want leased", attempt, n, err)
+		}
+		if _, err := doc.Update(ctx, []firestore.Update{{Path: "dueAt", Value: time.Now().Add(-time.Second)}}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if n, _, err := s.leaseScheduled(ctx, doc); err != nil || n != nil {
+		t.Errorf("leaseScheduled after the attempts = %v, %v, want nothing leased", n, err)
+	}
+	if n := scheduledAt(t, s, doc); n.State != v1.ScheduledNotification_FAILED || n.Attempts != schedulerMaxAttempts {
+		t.Errorf("notification = %v, want FAILED after %d attempts", n, schedulerMaxAttempts)
+	}
+
+	// retryable errors of the last attempt fail the notification
+	doc = scheduleDue(t, s, "n2", "t1")
+	if _, err := doc.Update(ctx, []firestore.Update{{Path: "attempts", Value: schedulerMaxAttempts - 1}}); err != nil {
+		t.Fatal(err)
+	}
+	_, lease, err := s.leaseScheduled(ctx, doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.finishScheduled(ctx, doc, lease, status.Error(codes.Unavailable, "unavailable")); err != nil {
+		t.Fatal(err)
+	}
+	if n := scheduledAt(t, s, doc); n.State != v1.ScheduledNotification_FAILED {
+		t.Errorf("notification = %v, want FAILED", n)
+	}
+}
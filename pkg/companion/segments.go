@@ -0,0 +1,130 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+const (
+	segmentsCollection = "fcm-companion-segments"
+)
+
+// segmentDoc returns the document of the segment, or an InvalidArgument error
+// if the id can't be a document id
+func (s *Service) segmentDoc(id string) (*firestore.DocumentRef, error) {
+	if strings.Contains(id, "/") {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid segment id %q", id)
+	}
+
+	return s.FirestoreClient.Collection(s.CollectionPrefix + segmentsCollection).Doc(id), nil
+}
+
+func (s *Service) CreateSegment(ctx context.Context, r *v1.Segment) (*v1.Segment, error) {
+	if err := r.Validate(); err != nil {
+		return &v1.Segment{}, err
+	}
+
+	if _, err := parseAudience(r.Audience); err != nil {
+		return &v1.Segment{}, err
+	}
+
+	doc := s.FirestoreClient.Collection(s.CollectionPrefix + segmentsCollection).NewDoc()
+	if r.Id != "" {
+		var err error
+		if doc, err = s.segmentDoc(r.Id); err != nil {
+			return &v1.Segment{}, err
+		}
+	}
+
+	r.CreatedAt = ptypes.TimestampNow()
+	_, err := doc.Create(ctx, r)
+	if status.Code(err) == codes.AlreadyExists {
+		return &v1.Segment{}, status.Errorf(codes.AlreadyExists, "segment %q already exists", doc.ID)
+	} else if err != nil {
+		return &v1.Segment{}, err
+	}
+	r.Id = doc.ID
+
+	s.Info("Segment was created", zap.String("id", r.Id), zap.String("audience", r.Audience))
+
+	return r, nil
+}
+
+func (s *Service) ListSegments(ctx context.Context, r *v1.ListSegmentsRequest) (*v1.SegmentList, error) {
+	if err := r.Validate(); err != nil {
+		return &v1.SegmentList{}, err
+	}
+
+	q := s.FirestoreClient.Collection(s.CollectionPrefix+segmentsCollection).OrderBy(firestore.DocumentID, firestore.Asc)
+
+	// the page token is the id of the last segment of the previous page
+	if r.PageToken != "" {
+		q = q.StartAfter(r.PageToken)
+	}
+
+	pageSize := pageSize(r.PageSize)
+	docs, err := q.Limit(pageSize).Documents(ctx).GetAll()
+	if err != nil {
+		return &v1.SegmentList{}, err
+	}
+
+	list := &v1.SegmentList{}
+	for _, doc := range docs {
+		seg := &v1.Segment{}
+		if err := doc.DataTo(seg); err != nil {
+			return &v1.SegmentList{}, err
+		}
+		seg.Id = doc.Ref.ID
+
+		list.Segments = append(list.Segments, seg)
+	}
+
+	if len(docs) == pageSize {
+		list.NextPageToken = docs[len(docs)-1].Ref.ID
+	}
+
+	return list, nil
+}
+
+func (s *Service) DeleteSegment(ctx context.Context, r *v1.DeleteSegmentRequest) (*empty.Empty, error) {
+	if err := r.Validate(); err != nil {
+		return &empty.Empty{}, err
+	}
+
+	doc, err := s.segmentDoc(r.Id)
+	if err != nil {
+		return &empty.Empty{}, err
+	}
+
+	_, err = doc.Delete(ctx)
+	return &empty.Empty{}, err
+}
+
+// segmentAudience returns the audience expression of the segment
+func (s *Service) segmentAudience(ctx context.Context, id string) (string, error) {
+	doc, err := s.segmentDoc(id)
+	if err != nil {
+		return "", err
+	}
+
+	snap, err := doc.Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return "", status.Errorf(codes.NotFound, "segment %q not found", id)
+	} else if err != nil {
+		return "", err
+	}
+
+	seg := &v1.Segment{}
+	if err := snap.DataTo(seg); err != nil {
+		return "", err
+	}
+
+	return seg.Audience, nil
+}
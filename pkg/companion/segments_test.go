@@ -0,0 +1,99 @@
+package companion
+
+import (
+	"context"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+	"testing"
+)
+
+func TestSegments(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	for _, seg := range []*v1.Segment{
+		{Id: "android", Name: "Android", Audience: `platform = "ANDROID"`},
+		{Id: "trial", Name: "Trial", Audience: `labels.plan = "trial"`},
+		{Name: "Generated", Audience: `ref = "u1"`},
+	} {
+		created, err := s.CreateSegment(ctx, seg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if created.Id == "" || created.CreatedAt == nil {
+			t.Errorf("segment = %v, want the id and the creation time", created)
+		}
+	}
+
+	if _, err := s.CreateSegment(ctx, &v1.Segment{Id: "trial", Name: "Trial", Audience: `ref = "u2"`}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("CreateSegment of an existing id = %v, want AlreadyExists", err)
+	}
+	if _, err := s.CreateSegment(ctx, &v1.Segment{Id: "broken", Name: "Broken", Audience: `platform =`}); err == nil {
+		t.Error("segment with an invalid audience was created")
+	}
+
+	var ids []string
+	r := &v1.ListSegmentsRequest{PageSize: 2}
+	for {
+		list, err := s.ListSegments(ctx, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, seg := range list.Segments {
+			ids = append(ids, seg.Id)
+		}
+		if list.NextPageToken == "" {
+			break
+		}
+		r.PageToken = list.NextPageToken
+	}
+	if len(ids) != 3 || !sort.StringsAreSorted(ids) {
+		t.Errorf("segments = %v, want all three ordered by their ids", ids)
+	}
+
+	audience, err := s.segmentAudience(ctx, "trial")
+	if err != nil || audience != `labels.plan = "trial"` {
+		t.Errorf("segmentAudience = %q, %v, want the trial audience", audience, err)
+	}
+
+	if _, err := s.DeleteSegment(ctx, &v1.DeleteSegmentRequest{Id: "trial"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.segmentAudience(ctx, "trial"); status.Code(err) != codes.NotFound {
+		t.Errorf("segmentAudience of a deleted segment = %v, want NotFound", err)
+	}
+}
+
+func TestSegmentPathIDs(t *testing.T) {
+	s, f := newTestService(t)
+	ctx := context.Background()
+
+	calls := map[string]func(id string) error{
+		"CreateSegment": func(id string) error {
+			_, err := s.CreateSegment(ctx, &v1.Segment{Id: id, Name: "Nested", Audience: `ref = "u1"`})
+			return err
+		},
+		"DeleteSegment": func(id string) error {
+			_, err := s.DeleteSegment(ctx, &v1.DeleteSegmentRequest{Id: id})
+			return err
+		},
+		"segmentAudience": func(id string) error {
+			_, err := s.segmentAudience(ctx, id)
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		for _, id := range []string{"a/b", "a/b/c", "/"} {
+			if err := call(id); status.Code(err) != codes.InvalidArgument {
+				t.Errorf("%s(%q) = %v, want InvalidArgument", name, id, err)
+			}
+		}
+	}
+
+	if n := f.count(segmentsCollection); n != 0 {
+		t.Errorf("%d segments are stored, want none", n)
+	}
+}
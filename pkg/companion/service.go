@@ -4,15 +4,28 @@ import (
 	"cloud.google.com/go/firestore"
 	"context"
 	"firebase.google.com/go/v4/messaging"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
 	"go.uber.org/zap"
+	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
 )
 
 const (
 	instancesCollection = "fcm-companion-instances"
+
+	// defaultPageSize is used for list calls that don't set the page size
+	defaultPageSize = 50
+	// maxPageSize is the maximum number of items returned by list calls
+	maxPageSize = 500
+	// maxScannedInstances is the maximum number of instances read by a single ListInstances
+	// call when filtering the instances in memory
+	maxScannedInstances = 2 * maxPageSize
 )
 
 // Service is the implementation of the Notification API
@@ -27,6 +40,33 @@ type Service struct {
 	// This is useful when there would be conflict with already existing collections
 	CollectionPrefix string
 
+	// TokenConflict defines how PutInstance handles a token held by another instance
+	TokenConflict TokenConflict
+
+	// MaxDevicesPerRef limits the number of instances of a single ref. The least
+	// recently seen instances are evicted when exceeded. Zero means unlimited
+	MaxDevicesPerRef int
+
+	// TokenMaxAge and InstanceMaxAge are the default ages of last seen instances
+	// after which their tokens, or the whole instances, are removed by the cleanup.
+	// Default to 60 and 270 days
+	TokenMaxAge    time.Duration
+	InstanceMaxAge time.Duration
+
+	// IdempotencyTTL is how long idempotency keys of the send requests are remembered.
+	// Defaults to 24 hours
+	IdempotencyTTL time.Duration
+
+	// SendLimiter limits the rate of messages sent to FCM across all requests of this
+	// service, see NewSendLimiter. The limit is per replica, the FCM quota must be split
+	// by the number of replicas. Nil means unlimited
+	SendLimiter *rate.Limiter
+	// RetryPolicy defines how messages rejected by FCM transiently are sent again.
+	// Defaults to the DefaultRetryPolicy
+	RetryPolicy *RetryPolicy
+	// quota holds the sends after FCM rejected messages by the quota
+	quota quotaPause
+
 	// config is the current configuration of the service. It's never null unless the
 	// service is called without the New() initializer
 	config *v1.NotificationConfig
@@ -42,23 +82,94 @@ func (s *Service) RegisterGateway(ctx context.Context, mux *runtime.ServeMux, bi
 	return v1.RegisterNotificationServiceHandlerFromEndpoint(ctx, mux, bind, opts)
 }
 
+// RegisterGatewayServer registers this service to the provided http mux, serving
+// the gateway calls in-process
+func (s *Service) RegisterGatewayServer(ctx context.Context, mux *runtime.ServeMux) error {
+	return v1.RegisterNotificationServiceHandlerServer(ctx, mux, s)
+}
+
 func (s *Service) PutInstance(ctx context.Context, i *v1.AppInstance) (*empty.Empty, error) {
 	if err := i.Validate(); err != nil {
 		return &empty.Empty{}, err
 	}
 
+	if err := validateInstanceMask(i.UpdateMask); err != nil {
+		return &empty.Empty{}, err
+	}
+
+	if err := validateTimezone(i); err != nil {
+		return &empty.Empty{}, err
+	}
+
 	// get the document reference (this won't read it)
-	doc := s.FirestoreClient.Doc(s.CollectionPrefix + instancesCollection + "/" + i.InstanceId)
+	doc := s.instanceDoc(i.InstanceId)
+
+	// the document is read in a transaction to stamp the server-side timestamps
+	err := s.FirestoreClient.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		snap, err := tx.Get(doc)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+
+		stored := &v1.AppInstance{}
+		if snap.Exists() {
+			if err := snap.DataTo(stored); err != nil {
+				return err
+			}
+		}
+
+		// merged writes keep the stored token if none is sent
+		tokenWritten := i.Token != "" || len(i.Labels) > 0
+		if i.UpdateMask != nil {
+			tokenWritten = masks(i.UpdateMask, "token")
+		}
+
+		if tokenWritten && i.Token != "" && i.Token != stored.Token {
+			if err := s.claimToken(tx, i); err != nil {
+				return err
+			}
+		}
 
-	// we don't need to read the document if we can just merge it
-	if len(i.Labels) <= 0 {
-		_, err := doc.Set(ctx, i, firestore.MergeAll)
+		stampInstance(i, stored, snap.Exists(), tokenWritten)
+
+		if i.UpdateMask != nil {
+			data, paths := maskedInstance(i, i.UpdateMask.Paths)
+			return tx.Set(doc, data, firestore.Merge(paths...))
+		}
+
+		// we don't need to rewrite the document if we can just merge the sent fields
+		if len(i.Labels) <= 0 {
+			data, paths := maskedInstance(i, sentInstanceFields(i))
+			return tx.Set(doc, data, firestore.Merge(paths...))
+		}
+
+		// overwrite the labels if set, with the whole doc
+		return tx.Set(doc, i)
+	})
+	if err != nil {
 		return &empty.Empty{}, err
 	}
 
-	// overwrite the labels if set, with the whole doc
-	_, err := doc.Set(ctx, i)
-	return &empty.Empty{}, err
+	return &empty.Empty{}, s.evictDevices(ctx, i.Ref)
+}
+
+// stampInstance sets the server-side timestamps of the instance being written
+// over the stored one. Timestamps sent by the client are ignored
+func stampInstance(i, stored *v1.AppInstance, exists, tokenWritten bool) {
+	now := ptypes.TimestampNow()
+
+	i.UpdatedAt = now
+	i.LastSeenAt = now
+	i.CreatedAt = stored.CreatedAt
+	i.TokenUpdatedAt = stored.TokenUpdatedAt
+
+	if !exists {
+		i.CreatedAt = now
+	}
+
+	if tokenWritten && i.Token != stored.Token {
+		i.TokenUpdatedAt = now
+	}
 }
 
 func (s *Service) RemoveToken(ctx context.Context, r *v1.RemoveTokenRequest) (*empty.Empty, error) {
@@ -69,10 +180,17 @@ func (s *Service) RemoveToken(ctx context.Context, r *v1.RemoveTokenRequest) (*e
 	doc := s.FirestoreClient.Doc(s.CollectionPrefix + instancesCollection + "/" + r.InstanceId)
 
 	// this only resets the value of the token for the instance ID
+	now := time.Now()
 	_, err := doc.Update(ctx, []firestore.Update{
 		{
 			Path: "token", Value: "",
 		},
+		{
+			Path: "tokenUpdatedAt", Value: now,
+		},
+		{
+			Path: "updatedAt", Value: now,
+		},
 	})
 
 	return &empty.Empty{}, err
@@ -90,33 +208,496 @@ func (s *Service) RemoveInstance(ctx context.Context, r *v1.RemoveInstanceReques
 }
 
 func (s *Service) Send(ctx context.Context, r *v1.SendRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, s.deliver(ctx, MethodSend, r, func(ctx context.Context) error {
+		return s.idempotent(ctx, MethodSend, idempotencyMessage, r.GetMessage().GetIdempotencyKey(), func() error {
+			return s.send(ctx, r)
+		})
+	})
+}
+
+func (s *Service) send(ctx context.Context, r *v1.SendRequest) error {
 	if err := r.Validate(); err != nil {
-		return &empty.Empty{}, err
+		return err
+	}
+
+	msg, err := s.buildMessage(r.Message)
+	if err != nil {
+		return err
+	}
+
+	if isScheduled(r.Message.SendAt) {
+		return s.scheduleMessage(ctx, r.Message)
+	}
+
+	if d := s.digestFor(ctx, r.Message); d != nil {
+		return s.addToDigest(ctx, r.Message, d)
+	}
+
+	if env, ok := envelopeFromContext(ctx); ok {
+		env.applyPriority(&msg.Android, &msg.APNS)
+	}
+
+	m := r.Message
+	p := s.newSendPolicy(m.IdempotencyKey, m.TemplateId, m.TemplateData, m.Data, m.Critical, capCounter{})
+	if m.Ref != "" || m.Audience != "" {
+		return s.multicastTargets(ctx, m.Ref, m.Audience, &messaging.MulticastMessage{
+			Data:         msg.Data,
+			Notification: msg.Notification,
+			Android:      msg.Android,
+			Webpush:      msg.Webpush,
+			APNS:         msg.APNS,
+		}, p)
+	}
+
+	var instances map[string]*v1.AppInstance
+	if msg.Token != "" {
+		if instances, err = s.tokenInstances(ctx, []string{msg.Token}); err != nil {
+			return err
+		}
+
+		optedOut, err := s.optedOutTokens(ctx, p, []string{msg.Token}, instances)
+		if err != nil || optedOut[msg.Token] {
+			return err
+		}
+	}
+	if until := s.quietMessage(m, msg, instances); !until.IsZero() {
+		return s.deferMessage(ctx, m, "", until)
+	}
+
+	if msg.Token != "" {
+		capped, err := s.capTokens(ctx, p, []string{msg.Token}, instances)
+		if err != nil || capped[msg.Token] {
+			return err
+		}
 	}
 
-	panic("implement me")
+	if err = s.sendOne(ctx, msg); err != nil {
+		s.recordNotifications(ctx, []*v1.Notification{p.failed(msg, instances, err)})
+		return fcmError(err)
+	}
+
+	s.recordMessages(ctx, p, []*messaging.Message{msg}, instances)
+	return nil
 }
 
 func (s *Service) SendAll(ctx context.Context, r *v1.SendAllRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, s.deliver(ctx, MethodSendAll, r, func(ctx context.Context) error {
+		return s.idempotent(ctx, MethodSendAll, idempotencyRequest, r.GetIdempotencyKey(), func() error {
+			return s.sendAll(ctx, r)
+		})
+	})
+}
+
+func (s *Service) sendAll(ctx context.Context, r *v1.SendAllRequest) error {
 	if err := r.Validate(); err != nil {
-		return &empty.Empty{}, err
+		return err
+	}
+
+	// messages with already seen idempotency keys are skipped, messages
+	// sent to a ref or an audience are expanded to all of their tokens,
+	// and scheduled and digested messages are sent later one by one
+	var msgs []*messaging.Message
+	var sent []*v1.Message
+	var keys, claimed []string
+
+	// results hold the result of each idempotency key, which succeeds if any
+	// of its messages was sent, digested, deferred or suppressed
+	results := map[string]error{}
+
+	// keys of the messages that were not sent are released on panics
+	defer func() {
+		if p := recover(); p != nil {
+			s.settleAll(ctx, claimed, results)
+			panic(p)
+		}
+	}()
+
+	for _, m := range r.Messages {
+		msg, err := s.buildMessage(m)
+		if err != nil {
+			s.settleAll(ctx, claimed, results)
+			return err
+		}
+
+		// scheduled messages are deduplicated by the id of the scheduled notification
+		if isScheduled(m.SendAt) {
+			if err := s.scheduleMessage(ctx, m); err != nil {
+				s.settleAll(ctx, claimed, results)
+				return err
+			}
+			continue
+		}
+
+		var key string
+		if m.IdempotencyKey != "" {
+			key = idempotencyKey(MethodSendAll, idempotencyMessage, m.IdempotencyKey)
+			ok, _, err := s.claim(ctx, key, s.idempotencyTTL())
+			if err != nil {
+				s.settleAll(ctx, claimed, results)
+				return err
+			}
+			if !ok {
+				s.Info("Duplicate message skipped", zap.String("key", key))
+				continue
+			}
+			claimed = append(claimed, key)
+		}
+
+		// digested messages are sent by the scheduler once the window of the digest ends
+		if d := s.digestFor(ctx, m); d != nil {
+			if err := s.addToDigest(ctx, m, d); err != nil {
+				s.settleAll(ctx, claimed, results)
+				return err
+			}
+			if key != "" {
+				results[key] = nil
+			}
+			continue
+		}
+
+		if env, ok := envelopeFromContext(ctx); ok {
+			env.applyPriority(&msg.Android, &msg.APNS)
+		}
+
+		targets := []*messaging.Message{msg}
+		if m.Ref != "" || m.Audience != "" {
+			if targets, err = s.targetMessages(ctx, m.Ref, m.Audience, msg); err != nil {
+				s.settleAll(ctx, claimed, results)
+				return err
+			}
+		}
+
+		for _, t := range targets {
+			msgs = append(msgs, t)
+			sent = append(sent, m)
+			keys = append(keys, key)
+		}
 	}
 
-	panic("implement me")
+	var tokens []string
+	for _, msg := range msgs {
+		if msg.Token != "" {
+			tokens = append(tokens, msg.Token)
+		}
+	}
+	instances, err := s.tokenInstances(ctx, tokens)
+	if err != nil {
+		s.settleAll(ctx, claimed, results)
+		return err
+	}
+
+	// the frequency caps count the refs of each message once, the policies of the
+	// messages share the counter to count the messages of the request as well
+	counter := capCounter{}
+	policies := map[*v1.Message]*sendPolicy{}
+	for _, m := range sent {
+		if policies[m] == nil {
+			key := m.IdempotencyKey
+			if key == "" {
+				key = r.IdempotencyKey
+			}
+			policies[m] = s.newSendPolicy(key, m.TemplateId, m.TemplateData, m.Data, m.Critical, counter)
+		}
+	}
+
+	// tokensOf returns the tokens of the remaining messages of each request message
+	tokensOf := func() map[*v1.Message][]string {
+		tokens := map[*v1.Message][]string{}
+		for i, msg := range msgs {
+			if msg.Token != "" {
+				tokens[sent[i]] = append(tokens[sent[i]], msg.Token)
+			}
+		}
+		return tokens
+	}
+
+	// drop removes the messages that were suppressed or deferred, their keys are settled
+	drop := func(dropped func(i int, msg *messaging.Message) bool) {
+		var kept int
+		for i, msg := range msgs {
+			if !dropped(i, msg) {
+				msgs[kept], sent[kept], keys[kept] = msg, sent[i], keys[i]
+				kept++
+				continue
+			}
+
+			if _, ok := results[keys[i]]; !ok {
+				results[keys[i]] = nil
+			}
+		}
+		msgs, sent, keys = msgs[:kept], sent[:kept], keys[:kept]
+	}
+
+	// recipients opted out of the categories are suppressed
+	optedOut := map[*v1.Message]map[string]bool{}
+	for m, tokens := range tokensOf() {
+		if optedOut[m], err = s.optedOutTokens(ctx, policies[m], tokens, instances); err != nil {
+			s.settleAll(ctx, claimed, results)
+			return err
+		}
+	}
+	drop(func(i int, msg *messaging.Message) bool {
+		return optedOut[sent[i]][msg.Token]
+	})
+
+	// messages in the quiet hours of their recipients are silenced or deferred
+	deferred := map[int]bool{}
+	for i, msg := range msgs {
+		until := s.quietMessage(sent[i], msg, instances)
+		if until.IsZero() {
+			continue
+		}
+
+		if err := s.deferMessage(ctx, sent[i], msg.Token, until); err != nil {
+			s.settleAll(ctx, claimed, results)
+			return err
+		}
+		deferred[i] = true
+	}
+	drop(func(i int, _ *messaging.Message) bool {
+		return deferred[i]
+	})
+
+	capped := map[*v1.Message]map[string]bool{}
+	remaining := tokensOf()
+	for _, m := range r.Messages {
+		if len(remaining[m]) == 0 {
+			continue
+		}
+		if capped[m], err = s.capTokens(ctx, policies[m], remaining[m], instances); err != nil {
+			s.settleAll(ctx, claimed, results)
+			return err
+		}
+	}
+	drop(func(i int, msg *messaging.Message) bool {
+		return capped[sent[i]][msg.Token]
+	})
+
+	// delivered and failed hold the outcomes to be recorded, including the ones before a failure
+	delivered := map[*v1.Message][]*messaging.Message{}
+	var failed []*v1.Notification
+	defer func() {
+		for _, m := range r.Messages {
+			if len(delivered[m]) > 0 {
+				s.recordMessages(ctx, policies[m], delivered[m], instances)
+			}
+		}
+		s.recordNotifications(ctx, failed)
+	}()
+
+	var failures int
+	var lastErr error
+	for start := 0; start < len(msgs); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(msgs) {
+			end = len(msgs)
+		}
+
+		res, err := s.sendMessages(ctx, msgs[start:end], false)
+		if err != nil {
+			// messages of the previous batches were sent, only the rest is released
+			s.settleAll(ctx, claimed, results)
+			return fcmError(err)
+		}
+
+		for i, sr := range res.Responses {
+			key := keys[start+i]
+			if sr.Success {
+				results[key] = nil
+				delivered[sent[start+i]] = append(delivered[sent[start+i]], msgs[start+i])
+				continue
+			}
+
+			if prev, ok := results[key]; !ok || prev != nil {
+				results[key] = fcmError(sr.Error)
+			}
+
+			failures++
+			lastErr = sr.Error
+			failed = append(failed, policies[sent[start+i]].failed(msgs[start+i], instances, sr.Error))
+			s.Warn("Message was not sent",
+				zap.String("templateID", sent[start+i].TemplateId),
+				zap.Error(sr.Error),
+			)
+		}
+	}
+
+	s.settleAll(ctx, claimed, results)
+
+	// partial failures are not returned, as the sent messages would be duplicated on retries
+	if len(msgs) > 0 && failures == len(msgs) {
+		return fcmError(lastErr)
+	}
+
+	return nil
+}
+
+// settleAll settles the claimed idempotency keys with their results. Keys without
+// results were not sent and are released
+func (s *Service) settleAll(ctx context.Context, keys []string, results map[string]error) {
+	for _, key := range keys {
+		if err, ok := results[key]; ok {
+			s.settle(ctx, key, err)
+			continue
+		}
+
+		if err := s.release(ctx, key); err != nil {
+			s.Error("Cannot release delivery", zap.String("key", key), zap.Error(err))
+		}
+	}
 }
 
 func (s *Service) SendMulticast(ctx context.Context, r *v1.SendMulticastRequest) (*empty.Empty, error) {
+	return &empty.Empty{}, s.deliver(ctx, MethodSendMulticast, r, func(ctx context.Context) error {
+		return s.idempotent(ctx, MethodSendMulticast, idempotencyMessage, r.GetMessage().GetIdempotencyKey(), func() error {
+			return s.sendMulticast(ctx, r)
+		})
+	})
+}
+
+func (s *Service) sendMulticast(ctx context.Context, r *v1.SendMulticastRequest) error {
 	if err := r.Validate(); err != nil {
-		return &empty.Empty{}, err
+		return err
+	}
+
+	// local times are scheduled when sent, so they can't be scheduled themselves
+	if r.Message.LocalTime != nil && r.Message.SendAt != nil {
+		return status.Error(codes.InvalidArgument, "local_time can't be used together with send_at")
+	}
+
+	msg, err := s.buildMulticastMessage(r.Message)
+	if err != nil {
+		return err
+	}
+
+	if isScheduled(r.Message.SendAt) {
+		return s.scheduleMulticastMessage(ctx, r.Message)
 	}
 
-	panic("implement me")
+	if env, ok := envelopeFromContext(ctx); ok {
+		env.applyPriority(&msg.Android, &msg.APNS)
+	}
+
+	expr := r.Message.Audience
+	if r.Message.SegmentId != "" {
+		if expr, err = s.segmentAudience(ctx, r.Message.SegmentId); err != nil {
+			return err
+		}
+	}
+
+	if r.Message.LocalTime != nil {
+		return s.scheduleLocalTime(ctx, r.Message, expr)
+	}
+
+	m := r.Message
+	p := s.newSendPolicy(m.IdempotencyKey, m.TemplateId, m.TemplateData, m.Data, m.Critical, capCounter{})
+	if expr != "" {
+		return s.multicastTargets(ctx, "", expr, msg, p)
+	}
+
+	return s.multicast(ctx, msg, p)
 }
 
-func (s *Service) ListNotifications(ctx context.Context, r *v1.ListNotificationsRequest) (*v1.NotificationList, error) {
-	if err := r.Validate(); err != nil {
-		return &v1.NotificationList{}, err
+// multicast sends the message to its tokens in batches. Tokens in their quiet hours
+// are sent silently or deferred, tokens of refs over the frequency cap are dropped or
+// deferred by the policy. Only the failure of all sent tokens is returned
+func (s *Service) multicast(ctx context.Context, msg *messaging.MulticastMessage, p *sendPolicy) error {
+	tokens := msg.Tokens
+	var sent, failures int
+	var lastErr error
+	for start := 0; start < len(tokens); start += maxBatchSize {
+		end := start + maxBatchSize
+		if end > len(tokens) {
+			end = len(tokens)
+		}
+
+		instances, err := s.tokenInstances(ctx, tokens[start:end])
+		if err != nil {
+			return err
+		}
+
+		// recipients opted out of the category are suppressed
+		optedOut, err := s.optedOutTokens(ctx, p, tokens[start:end], instances)
+		if err != nil {
+			return err
+		}
+
+		send, silent := without(tokens[start:end], optedOut), []string(nil)
+		if !p.critical {
+			var deferred map[time.Time][]string
+			send, silent, deferred = s.quietTokens(p.templateID, send, instances)
+			for at, tokens := range deferred {
+				if err := p.reschedule(ctx, tokens, at); err != nil {
+					return err
+				}
+			}
+		}
+
+		// deferred tokens are capped once they are sent
+		capped, err := s.capTokens(ctx, p, append(append([]string(nil), send...), silent...), instances)
+		if err != nil {
+			return err
+		}
+
+		var delivered []string
+		var failed []*v1.Notification
+		for _, batch := range []struct {
+			tokens []string
+			silent bool
+		}{{without(send, capped), false}, {without(silent, capped), true}} {
+			if len(batch.tokens) == 0 {
+				continue
+			}
+
+			m := *msg
+			m.Tokens = batch.tokens
+			if batch.silent {
+				silence(&m.Notification, &m.Android, &m.APNS, &m.Webpush)
+			}
+
+			res, err := s.sendMulticastMessage(ctx, &m)
+			if err != nil {
+				s.recordNotifications(ctx, append(p.sent(delivered, instances), failed...))
+				return fcmError(err)
+			}
+			sent += len(m.Tokens)
+
+			for i, sr := range res.Responses {
+				if sr.Success {
+					delivered = append(delivered, m.Tokens[i])
+					continue
+				}
+
+				failures++
+				lastErr = sr.Error
+				failed = append(failed, p.failed(&messaging.Message{Token: m.Tokens[i]}, instances, sr.Error))
+				s.Warn("Message was not sent",
+					zap.String("templateID", p.templateID),
+					zap.String("token", m.Tokens[i]),
+					zap.Error(sr.Error),
+				)
+			}
+		}
+
+		s.recordNotifications(ctx, append(p.sent(delivered, instances), failed...))
+	}
+
+	// partial failures are not returned, as the sent messages would be duplicated on retries
+	if sent > 0 && failures == sent {
+		return fcmError(lastErr)
+	}
+
+	return nil
+}
+
+// pageSize returns the size of the page limited by the maxPageSize
+func pageSize(requested int32) int {
+	if requested <= 0 {
+		return defaultPageSize
+	}
+	if requested > maxPageSize {
+		return maxPageSize
 	}
 
-	panic("implement me")
+	return int(requested)
 }
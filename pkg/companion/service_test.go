@@ -0,0 +1,108 @@
+package companion
+
+import (
+	"context"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func TestPutInstanceMetadata(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	get := func() *v1.AppInstance {
+		i, err := s.GetInstance(ctx, &v1.GetInstanceRequest{InstanceId: "instance1"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return i
+	}
+
+	if _, err := s.PutInstance(ctx, &v1.AppInstance{
+		InstanceId: "instance1",
+		Token:      "t1",
+		Platform:   v1.Platform_IOS,
+		AppVersion: "1.4.2",
+		// timestamps sent by the client are ignored
+		CreatedAt: &timestamp.Timestamp{Seconds: 1},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	created := get()
+	if created.Platform != v1.Platform_IOS || created.AppVersion != "1.4.2" {
+		t.Errorf("instance = %v, want the metadata stored", created)
+	}
+	if created.CreatedAt.Seconds == 1 || !proto.Equal(created.CreatedAt, created.TokenUpdatedAt) || !proto.Equal(created.CreatedAt, created.LastSeenAt) {
+		t.Errorf("instance = %v, want the server-side timestamps", created)
+	}
+
+	// a write without the metadata and with the same token keeps them
+	if _, err := s.PutInstance(ctx, &v1.AppInstance{InstanceId: "instance1", Token: "t1"}); err != nil {
+		t.Fatal(err)
+	}
+	seen := get()
+	if seen.Platform != v1.Platform_IOS || seen.AppVersion != "1.4.2" {
+		t.Errorf("instance = %v, want the metadata kept", seen)
+	}
+	if !proto.Equal(seen.CreatedAt, created.CreatedAt) || !proto.Equal(seen.TokenUpdatedAt, created.TokenUpdatedAt) {
+		t.Errorf("instance = %v, want the creation and token times kept", seen)
+	}
+	if proto.Equal(seen.LastSeenAt, created.LastSeenAt) {
+		t.Errorf("instance = %v, want the last seen time updated", seen)
+	}
+
+	if _, err := s.PutInstance(ctx, &v1.AppInstance{InstanceId: "instance1", Token: "t2"}); err != nil {
+		t.Fatal(err)
+	}
+	if rotated := get(); proto.Equal(rotated.TokenUpdatedAt, created.TokenUpdatedAt) {
+		t.Errorf("instance = %v, want the token time updated", rotated)
+	}
+}
+
+func TestPutInstanceUpdateMask(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	if _, err := s.PutInstance(ctx, &v1.AppInstance{
+		InstanceId: "instance1",
+		Token:      "t1",
+		Ref:        "u1",
+		AppVersion: "1.4.2",
+		Labels:     map[string]string{"beta": "true"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	// masked fields are written even if empty, the sent fields out of the mask are ignored
+	if _, err := s.PutInstance(ctx, &v1.AppInstance{
+		InstanceId: "instance1",
+		AppVersion: "2.0.0",
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"ref", "token"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	i, err := s.GetInstance(ctx, &v1.GetInstanceRequest{InstanceId: "instance1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i.Ref != "" || i.Token != "" {
+		t.Errorf("instance = %v, want the ref and the token cleared", i)
+	}
+	if i.AppVersion != "1.4.2" || i.Labels["beta"] != "true" {
+		t.Errorf("instance = %v, want the fields out of the mask kept", i)
+	}
+
+	_, err = s.PutInstance(ctx, &v1.AppInstance{
+		InstanceId: "instance1",
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"last_seen_at"}},
+	})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("PutInstance masking a server-side field = %v, want InvalidArgument", err)
+	}
+}
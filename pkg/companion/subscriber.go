@@ -0,0 +1,262 @@
+package companion
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"gocloud.dev/pubsub"
+	_ "gocloud.dev/pubsub/gcppubsub"
+	_ "gocloud.dev/pubsub/mempubsub"
+	pubsubpb "google.golang.org/genproto/googleapis/pubsub/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+	"sync"
+)
+
+const (
+	// MethodSend dispatches subscription messages as SendRequest
+	MethodSend = "Send"
+	// MethodSendAll dispatches subscription messages as SendAllRequest
+	MethodSendAll = "SendAll"
+	// MethodSendMulticast dispatches subscription messages as SendMulticastRequest
+	MethodSendMulticast = "SendMulticast"
+
+	// defaultMaxHandlers is used when the Subscribe is called without a positive
+	// number of handlers
+	defaultMaxHandlers = 10
+)
+
+// Subscription binds a pull subscription to the method its messages are dispatched to
+type Subscription struct {
+	// Method is one of MethodSend, MethodSendAll or MethodSendMulticast. It can be
+	// empty if all messages of the subscription set the AttributeMethod
+	Method string
+
+	// URL is the gocloud.dev/pubsub subscription URL, e.g.
+	// gcppubsub://projects/myproject/subscriptions/mysubscription or mem://mytopic
+	// The Pub/Sub emulator is used if the PUBSUB_EMULATOR_HOST is set
+	URL string
+}
+
+// ParseSubscriptions parses subscriptions defined as a comma separated list
+// of Method=URL pairs or URLs, e.g. "Send=gcppubsub://projects/p/subscriptions/send".
+// Messages of subscriptions without a method are routed by the AttributeMethod
+func ParseSubscriptions(s string) ([]Subscription, error) {
+	var subs []Subscription
+
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		// URLs may contain '=' in their query, so the method is only present
+		// if the part before the first '=' is not a part of the URL
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 || strings.Contains(parts[0], "://") {
+			subs = append(subs, Subscription{URL: pair})
+			continue
+		}
+
+		if parts[1] == "" {
+			return nil, fmt.Errorf("invalid subscription %q, expected Method=URL", pair)
+		}
+
+		switch parts[0] {
+		case MethodSend, MethodSendAll, MethodSendMulticast:
+		default:
+			return nil, fmt.Errorf("unknown method %q for subscription %q", parts[0], parts[1])
+		}
+
+		subs = append(subs, Subscription{Method: parts[0], URL: parts[1]})
+	}
+
+	return subs, nil
+}
+
+// Subscribe receives messages from all provided subscriptions and dispatches them
+// to the corresponding send methods until the context is canceled. At most maxHandlers
+// messages are processed concurrently across all subscriptions.
+// Messages are acknowledged on success, nacked on retryable failures so they are
+// redelivered, and stored as dead letters on permanent failures
+func (s *Service) Subscribe(ctx context.Context, subs []Subscription, maxHandlers int) error {
+	if maxHandlers <= 0 {
+		maxHandlers = defaultMaxHandlers
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// sem bounds the number of in-flight messages
+	sem := make(chan struct{}, maxHandlers)
+	errs := make(chan error, len(subs))
+	wg := &sync.WaitGroup{}
+
+	for _, sub := range subs {
+		subscription, err := pubsub.OpenSubscription(ctx, sub.URL)
+		if err != nil {
+			cancel()
+			wg.Wait()
+			return fmt.Errorf("error opening subscription %s: %v", sub.URL, err)
+		}
+
+		wg.Add(1)
+		go func(sub Subscription, subscription *pubsub.Subscription) {
+			defer wg.Done()
+
+			err := s.receive(ctx, sub.Method, sub.URL, subscription, sem)
+			if shutdownErr := subscription.Shutdown(context.Background()); err == nil {
+				err = shutdownErr
+			}
+
+			if err != nil {
+				errs <- fmt.Errorf("error receiving from %s: %v", sub.URL, err)
+				// stop other subscriptions as well
+				cancel()
+			}
+		}(sub, subscription)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	return <-errs
+}
+
+// receive runs the receive loop for a single subscription until the context is canceled
+func (s *Service) receive(ctx context.Context, method, subscription string, sub *pubsub.Subscription, sem chan struct{}) error {
+	handlers := &sync.WaitGroup{}
+	defer handlers.Wait()
+
+	for {
+		msg, err := sub.Receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		// the slot is acquired after receiving, so idle subscriptions don't hold
+		// the slots while busy ones wait
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			if msg.Nackable() {
+				msg.Nack()
+			}
+			return nil
+		}
+
+		handlers.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				handlers.Done()
+			}()
+
+			s.handleMessage(ctx, method, subscription, msg)
+		}()
+	}
+}
+
+// handleMessage dispatches the message and acks or nacks it based on the result.
+// The message attributes are passed the same way as for Pub/Sub push requests
+func (s *Service) handleMessage(ctx context.Context, method, subscription string, msg *pubsub.Message) {
+	env := &envelope{
+		Subscription: subscription,
+		Attributes:   msg.Metadata,
+	}
+	if env.Attributes == nil {
+		env.Attributes = map[string]string{}
+	}
+
+	var pm *pubsubpb.PubsubMessage
+	if msg.As(&pm) {
+		env.MessageID = pm.MessageId
+	}
+
+	if m := env.Attributes[AttributeMethod]; m != "" {
+		method = m
+	}
+
+	err := s.dispatch(env.newIncomingContext(ctx), method, msg.Body)
+	if err == nil {
+		msg.Ack()
+		return
+	}
+
+	if isRetryable(err) && msg.Nackable() {
+		s.Warn("Retryable failure, message will be redelivered",
+			zap.String("method", method),
+			zap.Error(err),
+		)
+		msg.Nack()
+		return
+	}
+
+	// permanent failures that were not dead lettered by the method (e.g. undecodable
+	// messages) are acknowledged and stored as dead letters, so they are not
+	// redelivered forever
+	if dlErr := s.deadLetterPayload(ctx, env, method, msg.Body, err); dlErr != nil {
+		s.Error("Cannot store dead letter, message will be redelivered",
+			zap.String("method", method),
+			zap.Error(dlErr),
+		)
+
+		if msg.Nackable() {
+			msg.Nack()
+		}
+		return
+	}
+
+	msg.Ack()
+}
+
+// dispatch decodes the body into the request of the method and calls it. Panics of the
+// method are returned as permanent panicErrors, so a single message can't crash the process
+func (s *Service) dispatch(ctx context.Context, method string, body []byte) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			s.Error("Method panicked", zap.String("method", method), zap.Any("panic", p), zap.Stack("stack"))
+			err = &panicError{method: method, value: p}
+		}
+	}()
+
+	switch method {
+	case MethodSend:
+		r := &v1.SendRequest{}
+		if err = unmarshalBody(body, r); err == nil {
+			_, err = s.Send(ctx, r)
+		}
+	case MethodSendAll:
+		r := &v1.SendAllRequest{}
+		if err = unmarshalBody(body, r); err == nil {
+			_, err = s.SendAll(ctx, r)
+		}
+	case MethodSendMulticast:
+		r := &v1.SendMulticastRequest{}
+		if err = unmarshalBody(body, r); err == nil {
+			_, err = s.SendMulticast(ctx, r)
+		}
+	default:
+		err = status.Errorf(codes.Unimplemented, "unknown method %q", method)
+	}
+
+	return err
+}
+
+// unmarshalBody decodes the JSON body the same way the gateway does for push requests
+func unmarshalBody(body []byte, m proto.Message) error {
+	u := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := u.Unmarshal(bytes.NewReader(body), m); err != nil {
+		return status.Errorf(codes.InvalidArgument, "cannot decode message: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package companion
+
+import (
+	"context"
+	"fmt"
+	"gocloud.dev/pubsub"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseSubscriptions(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []Subscription
+		err  bool
+	}{
+		{in: "", want: nil},
+		{
+			in: "Send=gcppubsub://projects/p/subscriptions/send, SendAll=mem://all",
+			want: []Subscription{
+				{Method: MethodSend, URL: "gcppubsub://projects/p/subscriptions/send"},
+				{Method: MethodSendAll, URL: "mem://all"},
+			},
+		},
+		{
+			in:   "mem://routed,,SendMulticast=mem://multicast?ackdeadline=10s",
+			want: []Subscription{{URL: "mem://routed"}, {Method: MethodSendMulticast, URL: "mem://multicast?ackdeadline=10s"}},
+		},
+		{
+			// the '=' in the query is not a method
+			in:   "mem://routed?a=b",
+			want: []Subscription{{URL: "mem://routed?a=b"}},
+		},
+		{in: "Send=", err: true},
+		{in: "Unknown=mem://topic", err: true},
+	}
+
+	for _, tt := range tests {
+		subs, err := ParseSubscriptions(tt.in)
+		if (err != nil) != tt.err {
+			t.Errorf("ParseSubscriptions(%q) error = %v, want error %v", tt.in, err, tt.err)
+			continue
+		}
+		if !tt.err && !reflect.DeepEqual(subs, tt.want) {
+			t.Errorf("ParseSubscriptions(%q) = %v, want %v", tt.in, subs, tt.want)
+		}
+	}
+}
+
+func TestDispatchPanic(t *testing.T) {
+	// the service has no messaging client, so sending panics
+	s, f := newTestService(t)
+	env := &envelope{MessageID: "m1", Attributes: map[string]string{}}
+
+	err := s.dispatch(env.newIncomingContext(context.Background()), MethodSend,
+		[]byte(`{"message": {"templateId": "hello", "token": "t1", "idempotencyKey": "k1"}}`))
+
+	if _, ok := err.(*panicError); !ok || status.Code(err) != codes.Internal {
+		t.Fatalf("dispatch = %v, want the Internal panic error", err)
+	}
+	if isRetryable(err) {
+		t.Error("panic is retryable")
+	}
+
+	// the delivery and the idempotency keys are released for the replay
+	if n := f.count(deliveriesCollection); n != 0 {
+		t.Errorf("%d deliveries are claimed, want the keys released", n)
+	}
+}
+
+func TestReceiveIdleSubscription(t *testing.T) {
+	s, f := newTestService(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// topics are shared by the process, so each run uses its own
+	open := func(name string) (*pubsub.Topic, *pubsub.Subscription) {
+		name = fmt.Sprintf("%s-%d", name, time.Now().UnixNano())
+		topic, err := pubsub.OpenTopic(ctx, "mem://"+name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sub, err := pubsub.OpenSubscription(ctx, "mem://"+name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() {
+			_ = sub.Shutdown(context.Background())
+			_ = topic.Shutdown(context.Background())
+		})
+		return topic, sub
+	}
+	_, idle := open("receive-idle")
+	busyTopic, busy := open("receive-busy")
+
+	// messages of an unknown method are dead lettered
+	for i := 0; i < 3; i++ {
+		if err := busyTopic.Send(ctx, &pubsub.Message{
+			Body:     []byte(`{}`),
+			Metadata: map[string]string{AttributeMethod: "Unknown"},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// the idle subscription waits for messages first, it must not hold the only slot
+	sem := make(chan struct{}, 1)
+	errs := make(chan error, 2)
+	go func() { errs <- s.receive(ctx, "", "idle", idle, sem) }()
+	time.Sleep(20 * time.Millisecond)
+	go func() { errs <- s.receive(ctx, "", "busy", busy, sem) }()
+
+	for deadline := time.Now().Add(5 * time.Second); f.count(deadLettersCollection) < 3; time.Sleep(10 * time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("%d messages were processed, want 3", f.count(deadLettersCollection))
+		}
+	}
+
+	cancel()
+	for i := 0; i < 2; i++ {
+		if err := <-errs; err != nil {
+			t.Errorf("receive = %v, want nil after the cancel", err)
+		}
+	}
+}
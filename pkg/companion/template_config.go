@@ -1,8 +1,13 @@
 package companion
 
 import (
+	"bytes"
 	"cloud.google.com/go/firestore"
 	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
 	v1 "github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
 	_ "gocloud.dev/runtimevar/gcpruntimeconfig"
 	"google.golang.org/api/iterator"
@@ -34,9 +39,69 @@ func fetchConfig(ctx context.Context, fsClient *firestore.Client, collectionPref
 			return nil, err
 		}
 
+		for _, t := range configPart.Messages {
+			if err := migrateTemplate(t); err != nil {
+				return nil, err
+			}
+		}
+
 		// transfer to the main config
 		config.Messages = append(config.Messages, configPart.Messages...)
+		if configPart.QuietHours != nil {
+			config.QuietHours = configPart.QuietHours
+		}
+		for category, q := range configPart.CategoryQuietHours {
+			if config.CategoryQuietHours == nil {
+				config.CategoryQuietHours = map[string]*v1.QuietHours{}
+			}
+			config.CategoryQuietHours[category] = q
+		}
+		for category, c := range configPart.CategoryFrequencyCaps {
+			if config.CategoryFrequencyCaps == nil {
+				config.CategoryFrequencyCaps = map[string]*v1.FrequencyCap{}
+			}
+			config.CategoryFrequencyCaps[category] = c
+		}
 	}
 
 	return config, nil
 }
+
+// migrateTemplate sets the fcm_message of a template configured with the legacy message,
+// whose values are the fields of the FCMMessage packed in Any, e.g. a Struct
+func migrateTemplate(t *v1.MessageTemplate) error {
+	if t.FcmMessage != nil || len(t.Message) == 0 {
+		return nil
+	}
+
+	fields := map[string]json.RawMessage{}
+	for field, value := range t.Message {
+		if value == nil {
+			continue
+		}
+
+		var unpacked ptypes.DynamicAny
+		if err := ptypes.UnmarshalAny(value, &unpacked); err != nil {
+			return fmt.Errorf("cannot migrate field %q of template %q: %v", field, t.Id, err)
+		}
+
+		js, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(unpacked.Message)
+		if err != nil {
+			return fmt.Errorf("cannot migrate field %q of template %q: %v", field, t.Id, err)
+		}
+		fields[field] = json.RawMessage(js)
+	}
+
+	js, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+
+	m := &v1.FCMMessage{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(js), m); err != nil {
+		return fmt.Errorf("cannot migrate template %q: %v", t.Id, err)
+	}
+	t.FcmMessage = m
+
+	return nil
+}
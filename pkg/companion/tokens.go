@@ -0,0 +1,168 @@
+package companion
+
+import (
+	"cloud.google.com/go/firestore"
+	"context"
+	"fmt"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+// TokenConflict defines how PutInstance handles a token that is already held
+// by another instance, e.g. after the application was reinstalled
+type TokenConflict int
+
+const (
+	// TokenConflictTransfer removes the token from the other instances
+	TokenConflictTransfer TokenConflict = iota
+	// TokenConflictReject rejects the PutInstance with an AlreadyExists error
+	TokenConflictReject
+)
+
+// ParseTokenConflict returns the TokenConflict by its name, either "transfer" or "reject".
+// An empty name returns the TokenConflictTransfer
+func ParseTokenConflict(name string) (TokenConflict, error) {
+	switch name {
+	case "", "transfer":
+		return TokenConflictTransfer, nil
+	case "reject":
+		return TokenConflictReject, nil
+	}
+
+	return 0, fmt.Errorf("unknown token conflict %q, expected transfer or reject", name)
+}
+
+// claimToken makes the instance the only holder of its token within the transaction,
+// based on the TokenConflict of the service
+func (s *Service) claimToken(tx *firestore.Transaction, i *v1.AppInstance) error {
+	q := s.FirestoreClient.Collection(s.CollectionPrefix+instancesCollection).Where("token", "==", i.Token)
+	docs, err := tx.Documents(q).GetAll()
+	if err != nil {
+		return err
+	}
+
+	var holders []*firestore.DocumentRef
+	for _, doc := range docs {
+		if doc.Ref.ID != i.InstanceId {
+			holders = append(holders, doc.Ref)
+		}
+	}
+	if len(holders) == 0 {
+		return nil
+	}
+
+	if s.TokenConflict == TokenConflictReject {
+		return status.Errorf(codes.AlreadyExists, "token is already registered to instance %q", holders[0].ID)
+	}
+
+	now := time.Now()
+	for _, doc := range holders {
+		err := tx.Update(doc, []firestore.Update{
+			{Path: "token", Value: ""},
+			{Path: "tokenUpdatedAt", Value: now},
+			{Path: "updatedAt", Value: now},
+		})
+		if err != nil {
+			return err
+		}
+
+		s.Info("Token was transferred",
+			zap.String("from", doc.ID),
+			zap.String("to", i.InstanceId),
+		)
+	}
+
+	return nil
+}
+
+func (s *Service) DeduplicateTokens(ctx context.Context, r *v1.DeduplicateTokensRequest) (*v1.DeduplicateTokensReport, error) {
+	if err := r.Validate(); err != nil {
+		return &v1.DeduplicateTokensReport{}, err
+	}
+
+	report := &v1.DeduplicateTokensReport{}
+
+	// instances are ordered by their tokens, so the holders of a token are scanned
+	// one after another and only the holders of the last token are kept across pages
+	var holders, duplicates []scannedInstance
+	deduplicate := func() {
+		if len(holders) < 2 {
+			return
+		}
+		report.DuplicateTokens++
+
+		// the most recently seen instance keeps the token
+		keeper := 0
+		for i := range holders {
+			if lastSeen(holders[i].AppInstance).After(lastSeen(holders[keeper].AppInstance)) {
+				keeper = i
+			}
+		}
+
+		for i := range holders {
+			if i != keeper {
+				duplicates = append(duplicates, holders[i])
+			}
+		}
+	}
+
+	// writes fail if the instance changed since it was scanned, e.g. it was registered again
+	removeDuplicates := func() error {
+		if r.DryRun {
+			report.TokensRemoved += int32(len(duplicates))
+			duplicates = nil
+			return nil
+		}
+
+		now := time.Now()
+		removed, err := s.writeUnchanged(ctx, duplicates, []firestore.Update{
+			{Path: "token", Value: ""},
+			{Path: "tokenUpdatedAt", Value: now},
+			{Path: "updatedAt", Value: now},
+		})
+		report.TokensRemoved += int32(removed)
+		duplicates = nil
+
+		return err
+	}
+
+	q := s.FirestoreClient.Collection(s.CollectionPrefix+instancesCollection).
+		Where("token", ">", "").
+		OrderBy("token", firestore.Asc)
+	err := s.scanInstances(ctx, q, func(snaps []*firestore.DocumentSnapshot) error {
+		for _, snap := range snaps {
+			i, err := instanceFromSnapshot(snap)
+			if err != nil {
+				return err
+			}
+			report.Scanned++
+
+			if len(holders) > 0 && holders[0].Token != i.Token {
+				deduplicate()
+				holders = nil
+			}
+			holders = append(holders, scannedInstance{AppInstance: i, updateTime: snap.UpdateTime})
+		}
+
+		return removeDuplicates()
+	})
+	if err == nil {
+		deduplicate()
+		err = removeDuplicates()
+	}
+	if err != nil {
+		return &v1.DeduplicateTokensReport{}, err
+	}
+
+	s.Info("Tokens were deduplicated",
+		zap.Bool("dryRun", r.DryRun),
+		zap.Int32("scanned", report.Scanned),
+		zap.Int32("duplicateTokens", report.DuplicateTokens),
+		zap.Int32("tokensRemoved", report.TokensRemoved),
+	)
+
+	return report, nil
+}
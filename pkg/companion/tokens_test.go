@@ -0,0 +1,122 @@
+package companion
+
+import (
+	"context"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseTokenConflict(t *testing.T) {
+	for name, want := range map[string]TokenConflict{
+		"":         TokenConflictTransfer,
+		"transfer": TokenConflictTransfer,
+		"reject":   TokenConflictReject,
+	} {
+		if got, err := ParseTokenConflict(name); err != nil || got != want {
+			t.Errorf("ParseTokenConflict(%q) = %v, %v, want %v", name, got, err, want)
+		}
+	}
+
+	if _, err := ParseTokenConflict("keep"); err == nil {
+		t.Error("ParseTokenConflict of an unknown name succeeded")
+	}
+}
+
+// instanceTokens returns the tokens of the stored instances by their ids
+func instanceTokens(t *testing.T, s *Service) map[string]string {
+	t.Helper()
+
+	docs, err := s.FirestoreClient.Collection(s.CollectionPrefix + instancesCollection).Documents(context.Background()).GetAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tokens := map[string]string{}
+	for _, doc := range docs {
+		i, err := instanceFromSnapshot(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tokens[i.InstanceId] = i.Token
+	}
+	return tokens
+}
+
+func TestPutInstanceTokenConflict(t *testing.T) {
+	ctx := context.Background()
+
+	s, _ := newTestService(t)
+	for _, id := range []string{"instance1", "instance2"} {
+		if _, err := s.PutInstance(ctx, &v1.AppInstance{InstanceId: id, Token: "t1"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if tokens := instanceTokens(t, s); !reflect.DeepEqual(tokens, map[string]string{"instance1": "", "instance2": "t1"}) {
+		t.Errorf("instances = %v, want the token transferred", tokens)
+	}
+
+	s, _ = newTestService(t)
+	s.TokenConflict = TokenConflictReject
+	if _, err := s.PutInstance(ctx, &v1.AppInstance{InstanceId: "instance1", Token: "t1"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.PutInstance(ctx, &v1.AppInstance{InstanceId: "instance2", Token: "t1"}); status.Code(err) != codes.AlreadyExists {
+		t.Errorf("PutInstance of a held token = %v, want AlreadyExists", err)
+	}
+	// the holder registers its token again
+	if _, err := s.PutInstance(ctx, &v1.AppInstance{InstanceId: "instance1", Token: "t1"}); err != nil {
+		t.Fatal(err)
+	}
+	if tokens := instanceTokens(t, s); !reflect.DeepEqual(tokens, map[string]string{"instance1": "t1"}) {
+		t.Errorf("instances = %v, want the token kept", tokens)
+	}
+}
+
+func TestDeduplicateTokens(t *testing.T) {
+	s, _ := newTestService(t)
+	ctx := context.Background()
+
+	// duplicates are written directly, as PutInstance would transfer the tokens
+	now := time.Now()
+	batch := s.FirestoreClient.Batch()
+	for id, data := range map[string]map[string]interface{}{
+		"instance1": {"token": "t1", "lastSeenAt": now.Add(-time.Hour)},
+		"instance2": {"token": "t1", "lastSeenAt": now},
+		"instance3": {"token": "t1"},
+		"instance4": {"token": "t2", "lastSeenAt": now},
+		"instance5": {"lastSeenAt": now},
+	} {
+		batch.Set(s.instanceDoc(id), data)
+	}
+	if _, err := batch.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	want := &v1.DeduplicateTokensReport{Scanned: 4, DuplicateTokens: 1, TokensRemoved: 2}
+
+	report, err := s.DeduplicateTokens(ctx, &v1.DeduplicateTokensRequest{DryRun: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("dry run = %v, want %v", report, want)
+	}
+
+	report, err = s.DeduplicateTokens(ctx, &v1.DeduplicateTokensRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(report, want) {
+		t.Errorf("DeduplicateTokens = %v, want %v", report, want)
+	}
+
+	// the most recently seen instance keeps the token
+	wantTokens := map[string]string{"instance1": "", "instance2": "t1", "instance3": "", "instance4": "t2", "instance5": ""}
+	if tokens := instanceTokens(t, s); !reflect.DeepEqual(tokens, wantTokens) {
+		t.Errorf("instances = %v, want %v", tokens, wantTokens)
+	}
+}
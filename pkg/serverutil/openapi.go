@@ -0,0 +1,106 @@
+package serverutil
+
+import (
+	"embed"
+	"github.com/petomalina/xrpc/pkg/multiplexer"
+	"io/fs"
+	"net/http"
+	"strings"
+)
+
+const (
+	// OpenAPIPath is the path on which the OpenAPI spec is served
+	OpenAPIPath = "/openapi.json"
+	// DocsPath is the path on which the Swagger UI is served, its assets are served
+	// under the path
+	DocsPath = "/docs"
+
+	// swaggerUICDN serves the Swagger UI assets unless they are vendored to the swaggerui.
+	// The version matches the SWAGGER_UI_VERSION vendored by `make swagger-ui`
+	swaggerUICDN = "https://unpkg.com/swagger-ui-dist@3.38.0/"
+)
+
+// swaggerUI holds the Swagger UI assets vendored by `make swagger-ui`. The assets
+// are not checked in, so only the README is embedded by default
+//
+//go:embed swaggerui
+var swaggerUI embed.FS
+
+// docsPage is a minimal Swagger UI page that loads its assets from the given base
+// and renders the spec served on the OpenAPIPath
+const docsPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8"/>
+  <title>API Docs</title>
+  <link rel="stylesheet" href="{{base}}swagger-ui.css"/>
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="{{base}}swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = function () {
+      window.ui = SwaggerUIBundle({url: "` + OpenAPIPath + `", dom_id: "#swagger-ui"});
+    };
+  </script>
+</body>
+</html>
+`
+
+// swaggerUIAssets returns the vendored Swagger UI assets, or nil if they were not vendored
+func swaggerUIAssets() fs.FS {
+	assets, err := fs.Sub(swaggerUI, "swaggerui")
+	if err != nil {
+		return nil
+	}
+	if _, err := fs.Stat(assets, "swagger-ui-bundle.js"); err != nil {
+		return nil
+	}
+
+	return assets
+}
+
+// IsGetPath returns a selector that matches GET requests on the exact path
+func IsGetPath(path string) multiplexer.Selector {
+	return func(r *http.Request) bool {
+		return r.Method == http.MethodGet && r.URL.Path == path
+	}
+}
+
+// OpenAPIHandler serves the given spec as a JSON document
+func OpenAPIHandler(spec []byte) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(spec)
+	})
+}
+
+// IsGetPathPrefix returns a selector that matches GET requests on paths with the prefix
+func IsGetPathPrefix(prefix string) multiplexer.Selector {
+	return func(r *http.Request) bool {
+		return r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, prefix)
+	}
+}
+
+// DocsHandler serves the Swagger UI page for the spec on the OpenAPIPath and the
+// embedded assets under the DocsPath. The assets are loaded from the CDN if they
+// were not vendored
+func DocsHandler() http.Handler {
+	base := swaggerUICDN
+	assets := http.NotFoundHandler()
+	if fsys := swaggerUIAssets(); fsys != nil {
+		base = DocsPath + "/"
+		assets = http.StripPrefix(DocsPath, http.FileServer(http.FS(fsys)))
+	}
+	page := []byte(strings.ReplaceAll(docsPage, "{{base}}", base))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != DocsPath && r.URL.Path != DocsPath+"/" {
+			assets.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write(page)
+	})
+}
@@ -0,0 +1,79 @@
+package serverutil
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestOpenAPIHandler(t *testing.T) {
+	spec := []byte(`{"swagger": "2.0"}`)
+
+	rec := httptest.NewRecorder()
+	OpenAPIHandler(spec).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, OpenAPIPath, nil))
+
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("content type = %q, want application/json", ct)
+	}
+	if rec.Body.String() != string(spec) {
+		t.Errorf("body = %q, want the spec", rec.Body.String())
+	}
+}
+
+func TestDocsHandler(t *testing.T) {
+	// the assets are loaded from the CDN unless they are vendored
+	base := swaggerUICDN
+	if swaggerUIAssets() != nil {
+		base = DocsPath + "/"
+	}
+
+	for _, path := range []string{DocsPath, DocsPath + "/"} {
+		rec := httptest.NewRecorder()
+		DocsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+		body := rec.Body.String()
+		if rec.Code != http.StatusOK || !strings.HasPrefix(rec.Header().Get("Content-Type"), "text/html") {
+			t.Errorf("%s: status = %d, content type = %q, want the docs page", path, rec.Code, rec.Header().Get("Content-Type"))
+		}
+		if !strings.Contains(body, `src="`+base+`swagger-ui-bundle.js"`) || !strings.Contains(body, `url: "`+OpenAPIPath+`"`) {
+			t.Errorf("%s: page = %s, want the bundle from %s rendering %s", path, body, base, OpenAPIPath)
+		}
+	}
+}
+
+func TestDocsHandlerAssets(t *testing.T) {
+	rec := httptest.NewRecorder()
+	DocsHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, DocsPath+"/swagger-ui-bundle.js", nil))
+
+	want := http.StatusNotFound
+	if swaggerUIAssets() != nil {
+		want = http.StatusOK
+	}
+	if rec.Code != want {
+		t.Errorf("status = %d, want %d", rec.Code, want)
+	}
+}
+
+func TestIsGetPath(t *testing.T) {
+	tests := []struct {
+		method, path  string
+		exact, prefix bool
+	}{
+		{http.MethodGet, "/docs", true, false},
+		{http.MethodGet, "/docs/swagger-ui.css", false, true},
+		{http.MethodGet, "/docsx", false, false},
+		{http.MethodPost, "/docs", false, false},
+		{http.MethodPost, "/docs/swagger-ui.css", false, false},
+	}
+
+	for _, tt := range tests {
+		r := httptest.NewRequest(tt.method, tt.path, nil)
+		if exact := IsGetPath(DocsPath)(r); exact != tt.exact {
+			t.Errorf("IsGetPath(%s %s) = %v, want %v", tt.method, tt.path, exact, tt.exact)
+		}
+		if prefix := IsGetPathPrefix(DocsPath + "/")(r); prefix != tt.prefix {
+			t.Errorf("IsGetPathPrefix(%s %s) = %v, want %v", tt.method, tt.path, prefix, tt.prefix)
+		}
+	}
+}
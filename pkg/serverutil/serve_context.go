@@ -2,6 +2,8 @@ package serverutil
 
 import (
 	"context"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"net/http"
 )
 
 // ServeContext encapsulates services and hooks for the Serve
@@ -16,6 +18,16 @@ type ServeContext struct {
 	gatewayEnabled bool
 	pubsubEnabled  bool
 
+	// pubsubMiddleware wraps the handler of pushed requests if set
+	pubsubMiddleware func(http.Handler) http.Handler
+
+	// openAPISpec is served on the OpenAPIPath if set
+	openAPISpec []byte
+	docsEnabled bool
+
+	// gatewayOptions are passed to the runtime.ServeMux when the gateway is enabled
+	gatewayOptions []runtime.ServeMuxOption
+
 	onListen func()
 	onExit   func()
 }
@@ -60,6 +72,36 @@ func WithGRPCGateway() ServeContextOption {
 	}
 }
 
+// WithGatewayOptions adds options used to create the gateway mux, e.g.
+// custom header matchers or error handlers
+func WithGatewayOptions(opts ...runtime.ServeMuxOption) ServeContextOption {
+	return func(c *ServeContext) {
+		c.gatewayOptions = append(c.gatewayOptions, opts...)
+	}
+}
+
+// WithGatewayJSON sets the JSON marshaling options used by the gateway for
+// all incoming and outgoing messages
+func WithGatewayJSON(m *runtime.JSONPb) ServeContextOption {
+	return WithGatewayOptions(runtime.WithMarshalerOption(runtime.MIMEWildcard, m))
+}
+
+// WithOpenAPI serves the given OpenAPI spec on the OpenAPIPath
+func WithOpenAPI(spec []byte) ServeContextOption {
+	return func(c *ServeContext) {
+		c.openAPISpec = spec
+	}
+}
+
+// WithDocs enables the Swagger UI on the DocsPath. The UI renders the spec
+// provided by the WithOpenAPI option. Unless the assets are vendored to the
+// swaggerui, the page loads them from the unpkg CDN
+func WithDocs() ServeContextOption {
+	return func(c *ServeContext) {
+		c.docsEnabled = true
+	}
+}
+
 // WithPubSub enables PubSub capabilities of the server. Enabling PubSub
 // also enables the gateway option
 func WithPubSub() ServeContextOption {
@@ -69,6 +111,14 @@ func WithPubSub() ServeContextOption {
 	}
 }
 
+// WithPubSubMiddleware wraps the handler of requests pushed by Pub/Sub. The middleware
+// receives the push envelope before it's unwrapped, e.g. to reject invalid messages
+func WithPubSubMiddleware(m func(http.Handler) http.Handler) ServeContextOption {
+	return func(c *ServeContext) {
+		c.pubsubMiddleware = m
+	}
+}
+
 // WithOnListen adds the onListen callback fired when the listening starts
 func WithOnListen(cb func()) ServeContextOption {
 	return func(c *ServeContext) {
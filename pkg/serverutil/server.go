@@ -2,6 +2,7 @@ package serverutil
 
 import (
 	"context"
+	"errors"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/petomalina/xrpc/pkg/multiplexer"
 	"google.golang.org/grpc"
@@ -23,8 +24,26 @@ type GRPCGateway interface {
 	RegisterGateway(ctx context.Context, mux *runtime.ServeMux, bind string, opts []grpc.DialOption) error
 }
 
+// GRPCGatewayServer is any structure that implements the RegisterGatewayServer method
+// which registers the service directly to the http mux. Calls are then served in-process
+// without dialing the grpc server. This is preferred over the GRPCGateway if both are
+// implemented.
+type GRPCGatewayServer interface {
+	RegisterGatewayServer(ctx context.Context, mux *runtime.ServeMux) error
+}
+
+var (
+	// ErrGatewayWithoutGRPC is returned when a service can only be registered to the gateway
+	// by dialing the grpc server, but the grpc capabilities are not enabled
+	ErrGatewayWithoutGRPC = errors.New("gateway registration needs grpc enabled, use WithGRPC or implement GRPCGatewayServer")
+)
+
+// Serve starts serving the provided services on a single port, multiplexing
+// grpc, Pub/Sub push and http gateway requests based on the enabled capabilities
 func Serve(opts ...ServeContextOption) error {
-	ctx := &ServeContext{}
+	ctx := &ServeContext{
+		ctx: context.Background(),
+	}
 	for _, o := range opts {
 		o(ctx)
 	}
@@ -50,27 +69,68 @@ func Serve(opts ...ServeContextOption) error {
 	}
 
 	if ctx.pubsubEnabled || ctx.gatewayEnabled {
-		gateway = runtime.NewServeMux()
+		gateway = runtime.NewServeMux(ctx.gatewayOptions...)
 	}
 
 	// pubsub must be registered before the gateway
 	if ctx.pubsubEnabled {
-		handlers = append(handlers, multiplexer.PubSubHandler(gateway))
+		if ctx.pubsubMiddleware == nil {
+			handlers = append(handlers, multiplexer.PubSubHandler(gateway))
+		} else {
+			unwrap := multiplexer.PubSubHandler(gateway)
+			push := ctx.pubsubMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				unwrap(w, r)
+			}))
+			handlers = append(handlers, multiplexer.HTTPHandler(push, multiplexer.IsPubSubRequest))
+		}
+	}
+
+	// the spec and docs must be registered before the gateway as it fulfills all requests
+	if ctx.openAPISpec != nil {
+		handlers = append(handlers, multiplexer.HTTPHandler(OpenAPIHandler(ctx.openAPISpec), IsGetPath(OpenAPIPath)))
+
+		if ctx.docsEnabled {
+			docs := DocsHandler()
+			handlers = append(handlers,
+				multiplexer.HTTPHandler(docs, IsGetPath(DocsPath)),
+				multiplexer.HTTPHandler(docs, IsGetPathPrefix(DocsPath+"/")),
+			)
+		}
 	}
 
 	if ctx.gatewayEnabled {
-		handlers = append(handlers, multiplexer.HTTPHandler(grpcServer))
+		handlers = append(handlers, multiplexer.HTTPHandler(gateway))
 	}
 
 	// register all services provided by the user
 	for _, svc := range ctx.services {
-		if s, ok := svc.(GRPCServer); ok {
+		if s, ok := svc.(GRPCServer); ok && grpcServer != nil {
 			s.Register(grpcServer)
 		}
 
+		if gateway == nil {
+			continue
+		}
+
+		// prefer the in-process registration as it doesn't need the grpc server
+		if s, ok := svc.(GRPCGatewayServer); ok {
+			if err := s.RegisterGatewayServer(ctx.ctx, gateway); err != nil {
+				_ = lis.Close()
+				return err
+			}
+			continue
+		}
+
 		if s, ok := svc.(GRPCGateway); ok {
+			// the gateway dials the grpc server on the same listener
+			if grpcServer == nil {
+				_ = lis.Close()
+				return ErrGatewayWithoutGRPC
+			}
+
 			err := s.RegisterGateway(ctx.ctx, gateway, bind, []grpc.DialOption{grpc.WithInsecure()})
 			if err != nil {
+				_ = lis.Close()
 				return err
 			}
 		}
@@ -0,0 +1,185 @@
+package serverutil
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/petomalina/fcm-companion/apis/go-sdk/notification/v1"
+	"github.com/petomalina/xrpc/pkg/multiplexer"
+	"google.golang.org/grpc/metadata"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// sendServer records the Send requests served through the gateway
+type sendServer struct {
+	v1.UnimplementedNotificationServiceServer
+
+	requests chan *v1.SendRequest
+	metadata chan metadata.MD
+}
+
+func (s *sendServer) Send(ctx context.Context, r *v1.SendRequest) (*empty.Empty, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.requests <- r
+	s.metadata <- md
+	return &empty.Empty{}, nil
+}
+
+func (s *sendServer) RegisterGatewayServer(ctx context.Context, mux *runtime.ServeMux) error {
+	return v1.RegisterNotificationServiceHandlerServer(ctx, mux, s)
+}
+
+// serve starts the server with the options on a free port and returns its address
+func serve(t *testing.T, opts ...ServeContextOption) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := fmt.Sprint(lis.Addr().(*net.TCPAddr).Port)
+	_ = lis.Close()
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- Serve(append(opts, WithPort(port))...)
+	}()
+
+	addr := "http://127.0.0.1:" + port
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		select {
+		case err := <-errs:
+			t.Fatalf("serving failed: %v", err)
+		default:
+		}
+
+		if res, err := http.Get(addr); err == nil {
+			_ = res.Body.Close()
+			return addr
+		}
+	}
+
+	t.Fatal("server didn't start")
+	return ""
+}
+
+func TestServe(t *testing.T) {
+	svc := &sendServer{
+		requests: make(chan *v1.SendRequest, 1),
+		metadata: make(chan metadata.MD, 1),
+	}
+	spec := []byte(`{"swagger": "2.0"}`)
+
+	// the middleware receives the pushed envelopes
+	envelopes := make(chan multiplexer.PushMessage, 1)
+	middleware := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			push := multiplexer.PushMessage{}
+			_ = json.Unmarshal(body, &push)
+			envelopes <- push
+
+			r.Body = ioutil.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(w, r)
+		})
+	}
+
+	addr := serve(t,
+		WithServices(svc),
+		WithGRPCGateway(),
+		WithPubSub(),
+		WithPubSubMiddleware(middleware),
+		WithOpenAPI(spec),
+		WithDocs(),
+	)
+
+	t.Run("openapi", func(t *testing.T) {
+		res, err := http.Get(addr + OpenAPIPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+
+		body, _ := ioutil.ReadAll(res.Body)
+		if res.StatusCode != http.StatusOK || !bytes.Equal(body, spec) {
+			t.Errorf("GET %s = %d %s, want the spec", OpenAPIPath, res.StatusCode, body)
+		}
+	})
+
+	t.Run("docs", func(t *testing.T) {
+		res, err := http.Get(addr + DocsPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+
+		body, _ := ioutil.ReadAll(res.Body)
+		if res.StatusCode != http.StatusOK || !strings.Contains(string(body), "swagger-ui-bundle.js") {
+			t.Errorf("GET %s = %d %s, want the docs page", DocsPath, res.StatusCode, body)
+		}
+	})
+
+	t.Run("gateway", func(t *testing.T) {
+		res, err := http.Post(addr+"/send", "application/json", strings.NewReader(`{"message": {"token": "t1"}}`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = res.Body.Close()
+
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("POST /send = %d, want 200", res.StatusCode)
+		}
+		if r := <-svc.requests; r.GetMessage().GetToken() != "t1" {
+			t.Errorf("request = %v, want the message to t1", r)
+		}
+		<-svc.metadata
+	})
+
+	t.Run("pubsub", func(t *testing.T) {
+		push, _ := json.Marshal(multiplexer.PushMessage{
+			Message: &multiplexer.PubSubMessage{
+				Data:       []byte(`{"message": {"token": "t2"}}`),
+				Attributes: map[string]string{"tenant": "acme"},
+				MessageID:  "m1",
+			},
+			Subscription: "projects/p/subscriptions/send",
+		})
+
+		req, _ := http.NewRequest(http.MethodPost, addr+"/send", bytes.NewReader(push))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", "APIs-Google; (+https://developers.google.com/webmasters/APIs-Google.html)")
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_ = res.Body.Close()
+
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("push = %d, want 200", res.StatusCode)
+		}
+		if env := <-envelopes; env.Message == nil || env.Message.MessageID != "m1" {
+			t.Errorf("middleware received %+v, want the envelope of m1", env)
+		}
+		if r := <-svc.requests; r.GetMessage().GetToken() != "t2" {
+			t.Errorf("request = %v, want the message to t2", r)
+		}
+
+		md := <-svc.metadata
+		for key, want := range map[string]string{
+			multiplexer.PubSubMetaAttributeHeader(multiplexer.PubSubMetaMessageID): "m1",
+			multiplexer.PubSubAttributeHeader("tenant"):                            "acme",
+		} {
+			if got := md.Get(key); len(got) != 1 || got[0] != want {
+				t.Errorf("metadata %s = %v, want %s", key, got, want)
+			}
+		}
+	})
+}